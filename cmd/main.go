@@ -20,10 +20,14 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"strings"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/server"
+	"github.com/datacommonsorg/mixer/internal/server/convert"
+	"github.com/datacommonsorg/mixer/internal/server/geo"
 	"github.com/datacommonsorg/mixer/internal/server/healthcheck"
+	"github.com/datacommonsorg/mixer/internal/server/placeindex"
 	"github.com/datacommonsorg/mixer/internal/server/resource"
 	"github.com/datacommonsorg/mixer/internal/store/memdb"
 	"golang.org/x/oauth2/google"
@@ -56,9 +60,17 @@ var (
 	useBranchBt = flag.Bool("use_branch_bt", false, "Use branch bigtable cache")
 	// GCS to hold memdb data.
 	// Note GCS bucket and pubsub should be within the mixer project.
-	useTmcfCsvData = flag.Bool("use_tmcf_csv_data", false, "Use tmcf and csv data")
-	tmcfCsvBucket  = flag.String("tmcf_csv_bucket", "", "The GCS bucket that contains tmcf and csv files")
-	tmcfCsvFolder  = flag.String("tmcf_csv_folder", "", "GCS folder for an import. An import must have a unique prefix within a bucket.")
+	useTmcfCsvData       = flag.Bool("use_tmcf_csv_data", false, "Use tmcf and csv data")
+	tmcfCsvBucket        = flag.String("tmcf_csv_bucket", "", "The GCS bucket that contains tmcf and csv files")
+	tmcfCsvFolder        = flag.String("tmcf_csv_folder", "", "GCS folder for an import. An import must have a unique prefix within a bucket.")
+	aggregateTmcfCsvData = flag.Bool("aggregate_tmcf_csv_data", false, "Aggregate tmcf and csv data to ancestor places for stat vars with sum aggregation in manifest")
+	// Ranking and unit config.
+	rankingConfigPath = flag.String("ranking_config_path", "", "JSON file of source ranking rules, local or in GCS, replacing the built-in ranking")
+	unitRegistryPath  = flag.String("unit_registry_path", "", "JSON file of convertible units, replacing the built-in unit registry")
+	// Spatial index of places.
+	geoIndexPath = flag.String("geo_index_path", "", "Comma separated CSV files of place triples, GeoJSON or KML files of place boundaries and gs:// prefixes of these files for the spatial index")
+	// Place name index.
+	placeIndexPath = flag.String("place_index_path", "", "CSV export of place dcid, name, typeOf, containedInPlace and population for the place name index")
 )
 
 const (
@@ -73,6 +85,9 @@ const (
 	// GCS Pubsub
 	tmcfCsvPubsubTopic      = "tmcf-csv-reload"
 	tmcfCsvSubscriberPrefix = "tmcf-csv-subscriber-"
+	// Ranking config Pubsub
+	rankingConfigPubsubTopic      = "ranking-config-reload"
+	rankingConfigSubscriberPrefix = "ranking-config-subscriber-"
 )
 
 func main() {
@@ -96,21 +111,45 @@ func main() {
 		}
 	}
 
-	// TMCF + CSV from GCS
-	memDb := memdb.NewMemDb()
-	if *useTmcfCsvData && *tmcfCsvBucket != "" {
-		err = memDb.LoadFromGcs(ctx, *tmcfCsvBucket, *tmcfCsvFolder)
-		if err != nil {
-			log.Fatalf("Failed to load tmcf and csv from GCS: %v", err)
+	// Unit registry.
+	if *unitRegistryPath != "" {
+		if err := convert.LoadUnits(*unitRegistryPath); err != nil {
+			log.Fatalf("Failed to load unit registry: %v", err)
 		}
-		err = memDb.SubscribeGcsUpdate(
-			ctx, *mixerProject, tmcfCsvPubsubTopic, tmcfCsvSubscriberPrefix,
-			*tmcfCsvBucket, *tmcfCsvFolder)
-		if err != nil {
-			log.Fatalf("Failed to subscribe to tmcf and csv change: %v", err)
+	}
+
+	// Spatial index of places.
+	if *geoIndexPath != "" {
+		if err := geo.LoadIndex(ctx, strings.Split(*geoIndexPath, ",")...); err != nil {
+			log.Fatalf("Failed to load spatial index: %v", err)
 		}
 	}
 
+	// Place name index.
+	if *placeIndexPath != "" {
+		if err := placeindex.LoadIndex(*placeIndexPath); err != nil {
+			log.Fatalf("Failed to load place index: %v", err)
+		}
+	}
+
+	// Source ranking config, reloaded on update when in GCS.
+	if *rankingConfigPath != "" {
+		if err := server.LoadRankingConfig(ctx, *rankingConfigPath); err != nil {
+			log.Fatalf("Failed to load ranking config: %v", err)
+		}
+		if strings.HasPrefix(*rankingConfigPath, "gs://") {
+			err := server.SubscribeRankingConfigUpdate(
+				ctx, *mixerProject, rankingConfigSubscriberPrefix,
+				rankingConfigPubsubTopic, *rankingConfigPath)
+			if err != nil {
+				log.Fatalf("Failed to subscribe to ranking config update: %v", err)
+			}
+		}
+	}
+
+	// In-memory database for TMCF + CSV data, loaded after the server is created.
+	memDb := memdb.NewMemDb()
+
 	// BigQuery.
 	var bqClient *bigquery.Client
 	var metadata *resource.Metadata
@@ -159,6 +198,24 @@ func main() {
 	// Create server object
 	s := server.NewServer(bqClient, baseTable, branchTable, metadata, cache, memDb)
 
+	// Load TMCF + CSV after the server is created, as aggregation reads place
+	// relations from Bigtable.
+	if *useTmcfCsvData && *tmcfCsvBucket != "" {
+		if *aggregateTmcfCsvData {
+			memDb.SetAfterLoad(s.AggregateMemDb)
+		}
+		err = memDb.LoadFromGcs(ctx, *tmcfCsvBucket, *tmcfCsvFolder)
+		if err != nil {
+			log.Fatalf("Failed to load tmcf and csv from GCS: %v", err)
+		}
+		err = memDb.SubscribeGcsUpdate(
+			ctx, *mixerProject, tmcfCsvPubsubTopic, tmcfCsvSubscriberPrefix,
+			*tmcfCsvBucket, *tmcfCsvFolder)
+		if err != nil {
+			log.Fatalf("Failed to subscribe to tmcf and csv change: %v", err)
+		}
+	}
+
 	// Subscribe to branch cache update
 	if *useBranchBt {
 		err := s.SubscribeBranchCacheUpdate(
@@ -0,0 +1,158 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package csvw parses W3C CSVW (CSV on the Web) metadata into the same table
+// schema as TMCF, so the described csv files can be imported without TMCF.
+//
+// Columns with the same aboutUrl describe the same node; the fragment of the
+// aboutUrl, like "E0" in "#E0", is used as the node name. A column maps to the
+// node property in its propertyUrl. Virtual columns set constant properties of
+// a node with propertyUrl and valueUrl, like typeOf and variableMeasured.
+package csvw
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/parser/tmcf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultNode is the node name when neither column nor table has aboutUrl.
+const defaultNode = "E0"
+
+type column struct {
+	Name string `json:"name"`
+	// Titles can be a string, a list of strings or a language map.
+	Titles      json.RawMessage `json:"titles"`
+	Virtual     bool            `json:"virtual"`
+	AboutURL    string          `json:"aboutUrl"`
+	PropertyURL string          `json:"propertyUrl"`
+	ValueURL    string          `json:"valueUrl"`
+}
+
+type tableSchema struct {
+	AboutURL string    `json:"aboutUrl"`
+	Columns  []*column `json:"columns"`
+}
+
+type table struct {
+	URL         string       `json:"url"`
+	TableSchema *tableSchema `json:"tableSchema"`
+}
+
+// metadata is either a single table or a group of tables.
+type metadata struct {
+	table
+	Tables []*table `json:"tables"`
+}
+
+// ParseCsvw parses CSVW metadata into a map with key of the table name, and
+// value being the TableSchema struct. The table name is the csv file name
+// without extension, same as for TMCF.
+func ParseCsvw(csvw string) (map[string]*tmcf.TableSchema, error) {
+	var m metadata
+	if err := json.Unmarshal([]byte(csvw), &m); err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid csvw metadata: %v", err)
+	}
+	tables := m.Tables
+	if len(tables) == 0 {
+		tables = []*table{&m.table}
+	}
+	result := map[string]*tmcf.TableSchema{}
+	for _, t := range tables {
+		if t.URL == "" || t.TableSchema == nil {
+			return nil, status.Errorf(
+				codes.Internal, "csvw table requires url and tableSchema")
+		}
+		name := strings.TrimSuffix(path.Base(t.URL), ".csv")
+		schema := &tmcf.TableSchema{
+			ColumnInfo: map[string][]*tmcf.Column{},
+			NodeSchema: map[string]map[string]string{},
+		}
+		for _, c := range t.TableSchema.Columns {
+			node := nodeName(c.AboutURL, t.TableSchema.AboutURL)
+			if c.Virtual {
+				if c.PropertyURL == "" || c.ValueURL == "" {
+					return nil, status.Errorf(codes.Internal,
+						"csvw virtual column %s requires propertyUrl and valueUrl", c.Name)
+				}
+				if _, ok := schema.NodeSchema[node]; !ok {
+					schema.NodeSchema[node] = map[string]string{}
+				}
+				schema.NodeSchema[node][tmcf.TrimNamespace(c.PropertyURL)] =
+					tmcf.TrimNamespace(c.ValueURL)
+				continue
+			}
+			header, err := columnTitle(c)
+			if err != nil {
+				return nil, err
+			}
+			property := c.Name
+			if c.PropertyURL != "" {
+				property = tmcf.TrimNamespace(c.PropertyURL)
+			}
+			schema.ColumnInfo[header] = append(
+				schema.ColumnInfo[header],
+				&tmcf.Column{Node: node, Property: property},
+			)
+		}
+		result[name] = schema
+	}
+	return result, nil
+}
+
+// nodeName derives the node name from the column or table aboutUrl.
+func nodeName(columnAboutURL, tableAboutURL string) string {
+	aboutURL := columnAboutURL
+	if aboutURL == "" {
+		aboutURL = tableAboutURL
+	}
+	if aboutURL == "" {
+		return defaultNode
+	}
+	if i := strings.LastIndex(aboutURL, "#"); i >= 0 {
+		aboutURL = aboutURL[i+1:]
+	}
+	return strings.Trim(aboutURL, "{}")
+}
+
+// columnTitle returns the csv header of a column, which is the first title or
+// the column name if there is no title.
+func columnTitle(c *column) (string, error) {
+	if len(c.Titles) > 0 {
+		var title string
+		if err := json.Unmarshal(c.Titles, &title); err == nil {
+			return title, nil
+		}
+		var titles []string
+		if err := json.Unmarshal(c.Titles, &titles); err == nil && len(titles) > 0 {
+			return titles[0], nil
+		}
+		var langTitles map[string]json.RawMessage
+		if err := json.Unmarshal(c.Titles, &langTitles); err == nil {
+			for _, lang := range []string{"en", "und"} {
+				if raw, ok := langTitles[lang]; ok {
+					return columnTitle(&column{Name: c.Name, Titles: raw})
+				}
+			}
+		}
+	}
+	if c.Name == "" {
+		return "", status.Errorf(codes.Internal, "csvw column requires name or titles")
+	}
+	return c.Name, nil
+}
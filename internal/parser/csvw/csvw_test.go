@@ -0,0 +1,72 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csvw
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/parser/tmcf"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseCsvw(t *testing.T) {
+	for _, c := range []struct {
+		file string
+		want map[string]*tmcf.TableSchema
+	}{
+		{
+			"svo.csv-metadata.json",
+			map[string]*tmcf.TableSchema{
+				"FBI_Crime": {
+					ColumnInfo: map[string][]*tmcf.Column{
+						"Count_CriminalActivities_MurderAndNonNegligentManslaughter": {{Node: "E1", Property: "value"}},
+						"Count_CriminalActivities_ViolentCrime":                      {{Node: "E0", Property: "value"}},
+						"GeoId": {
+							{Node: "E0", Property: "observationAbout"},
+							{Node: "E1", Property: "observationAbout"},
+						},
+						"Year": {
+							{Node: "E0", Property: "observationDate"},
+							{Node: "E1", Property: "observationDate"},
+						},
+					},
+					NodeSchema: map[string]map[string]string{
+						"E0": {
+							"measurementMethod": "FBI_Crime",
+							"typeOf":            "StatVarObservation",
+							"variableMeasured":  "Count_CriminalActivities_ViolentCrime",
+						},
+						"E1": {
+							"typeOf":           "StatVarObservation",
+							"variableMeasured": "Count_CriminalActivities_MurderAndNonNegligentManslaughter",
+						}},
+				},
+			},
+		},
+	} {
+		metadata, err := ioutil.ReadFile("testdata/" + c.file)
+		if err != nil {
+			t.Fatalf("reading csvw: %s", err)
+		}
+		tableSchema, err := ParseCsvw(string(metadata))
+		if err != nil {
+			t.Fatalf("parsing csvw file: %s", err)
+		}
+		if diff := cmp.Diff(tableSchema, c.want); diff != "" {
+			t.Errorf("ParseCsvw got diff: %v", diff)
+		}
+	}
+}
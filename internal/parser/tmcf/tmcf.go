@@ -35,6 +35,26 @@ type TableSchema struct {
 	NodeSchema map[string]map[string]string
 }
 
+// namespaces are the schema namespaces removed from property and value
+// references, as compact prefix or full IRI.
+var namespaces = []string{
+	"dcs:",
+	"dcid:",
+	"schema:",
+	"https://datacommons.org/",
+	"http://schema.org/",
+	"https://schema.org/",
+}
+
+// TrimNamespace removes the schema namespace of a reference like
+// "dcs:Count_Person" or "http://schema.org/name".
+func TrimNamespace(ref string) string {
+	for _, ns := range namespaces {
+		ref = strings.TrimPrefix(ref, ns)
+	}
+	return ref
+}
+
 // ParseTmcf parses TMCF into a map with key of the table name, and value being the
 // TableSchema struct.
 func ParseTmcf(tmcf string) (map[string]*TableSchema, error) {
@@ -88,10 +108,7 @@ func ParseTmcf(tmcf string) (map[string]*TableSchema, error) {
 			)
 		} else {
 			// This is a schema
-			schema := body
-			for _, prefix := range []string{"dcs:", "dcid:", "schema:"} {
-				schema = strings.TrimPrefix(schema, prefix)
-			}
+			schema := TrimNamespace(body)
 			// Remove quote in TMCF schema like:
 			// observationPeriod: "P1M"
 			schema = strings.Trim(schema, "\"")
@@ -55,7 +55,7 @@ var file_mixer_proto_rawDesc = []byte{
 	0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x83, 0x23, 0x0a, 0x05, 0x4d, 0x69, 0x78, 0x65, 0x72, 0x12,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xd6, 0x29, 0x0a, 0x05, 0x4d, 0x69, 0x78, 0x65, 0x72, 0x12,
 	0x5b, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
@@ -89,254 +89,308 @@ var file_mixer_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4,
 	0x93, 0x02, 0x23, 0x12, 0x0d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x69, 0x70, 0x6c,
 	0x65, 0x73, 0x5a, 0x12, 0x22, 0x0d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x69, 0x70,
-	0x6c, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6c, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x12, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x12, 0x0d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x5a, 0x12, 0x22, 0x0d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
-	0x12, 0x0f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x2d, 0x69,
-	0x6e, 0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x73, 0x2d, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x6e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x0b, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f,
-	0x73, 0x74, 0x61, 0x74, 0x73, 0x5a, 0x10, 0x22, 0x0b, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2f, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f,
-	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5a, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
-	0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0x7a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x0b, 0x2f,
-	0x73, 0x74, 0x61, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5a, 0x10, 0x22, 0x0b, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x0c, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5a, 0x11, 0x22, 0x0c, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x61, 0x6c, 0x6c, 0x5a,
-	0x0e, 0x22, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x61, 0x6c, 0x6c, 0x3a, 0x01, 0x2a, 0x12,
-	0xa0, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x27, 0x12, 0x0f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x2d,
+	0x69, 0x6e, 0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x2d, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x89, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x12, 0x23, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12,
+	0x0e, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x2d, 0x61, 0x72, 0x65, 0x61, 0x5a,
+	0x13, 0x22, 0x0e, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x2d, 0x61, 0x72, 0x65,
+	0x61, 0x3a, 0x01, 0x2a, 0x12, 0x6e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x0b, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x5a, 0x10, 0x22, 0x0b, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53,
-	0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x16, 0x2f,
-	0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x5a, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73,
-	0x65, 0x74, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a,
-	0x01, 0x2a, 0x12, 0xae, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65,
-	0x74, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x12,
-	0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x61, 0x74,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x13,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x5a, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f,
+	0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x0b, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5a, 0x10, 0x22, 0x0b, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x61, 0x74,
 	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x1a, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65,
-	0x74, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x61,
-	0x6c, 0x6c, 0x5a, 0x1f, 0x22, 0x1a, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f,
-	0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x61, 0x6c, 0x6c,
-	0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65,
-	0x74, 0x12, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x0c, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5a, 0x11, 0x22, 0x0c, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x61, 0x6c, 0x6c, 0x5a, 0x0e, 0x22, 0x09,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x61, 0x6c, 0x6c, 0x3a, 0x01, 0x2a, 0x12, 0xa0, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x69,
+	0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
 	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61,
-	0x74, 0x2f, 0x73, 0x65, 0x74, 0x5a, 0x0e, 0x22, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73,
-	0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xc0, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x1d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74,
-	0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x5a, 0x22, 0x22, 0x1d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65,
-	0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xaa, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
-	0x73, 0x12, 0x28, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x17,
-	0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5a, 0x1c, 0x22, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xa7, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x5a, 0x1c, 0x22, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x65, 0x64, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12,
-	0xb3, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x61, 0x67, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x61, 0x67, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x12, 0x0d, 0x2f, 0x6c, 0x61, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x2d, 0x70, 0x61, 0x67, 0x65, 0x5a, 0x12, 0x22, 0x0d, 0x2f, 0x6c, 0x61, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x61, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x5a, 0x11, 0x12, 0x0f,
-	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5a,
-	0x14, 0x22, 0x0f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6f, 0x50,
-	0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6f, 0x50, 0x61, 0x67, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e,
-	0x6f, 0x64, 0x65, 0x73, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x0d, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6f, 0x5a, 0x12, 0x22, 0x0d, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x6f, 0x3a, 0x01, 0x2a, 0x12,
-	0x6f, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1d, 0x12, 0x0a, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x5a,
-	0x0f, 0x22, 0x0a, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a,
-	0x12, 0x52, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x12, 0x5f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x90, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12,
-	0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2d, 0x76, 0x61,
-	0x72, 0x5a, 0x15, 0x22, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74,
-	0x73, 0x2d, 0x76, 0x61, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x90, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x24, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x29, 0x12, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d,
-	0x76, 0x61, 0x72, 0x73, 0x5a, 0x15, 0x22, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8e, 0x01, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x16, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x5a, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f,
+	0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0xae, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x29, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x53, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3d, 0x12, 0x1a, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x61, 0x6c, 0x6c, 0x5a,
+	0x1f, 0x22, 0x1a, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x77, 0x69, 0x74,
+	0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x61, 0x6c, 0x6c, 0x3a, 0x01, 0x2a,
+	0x12, 0x9d, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2d, 0x12, 0x12, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5a, 0x17, 0x22, 0x12, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a,
+	0x12, 0x98, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x43, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b,
+	0x12, 0x11, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5a, 0x16, 0x22, 0x11, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x5a, 0x0e, 0x22,
+	0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xc0, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x1d, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f,
+	0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5a, 0x22, 0x22, 0x1d,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a,
+	0x12, 0xaa, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5a,
+	0x1c, 0x22, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xa7, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37,
+	0x12, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x2d,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5a, 0x1c, 0x22, 0x17, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xb3, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x4c, 0x12, 0x0d, 0x2f, 0x6c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x61, 0x67, 0x65,
+	0x5a, 0x12, 0x22, 0x0d, 0x2f, 0x6c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x61, 0x67,
+	0x65, 0x3a, 0x01, 0x2a, 0x5a, 0x11, 0x12, 0x0f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x69, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x12, 0x0d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x62, 0x69, 0x6f, 0x5a, 0x12, 0x22, 0x0d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x62, 0x69, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x0a, 0x2f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x5a, 0x0f, 0x22, 0x0a, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x52, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x12, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x5f, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x44, 0x62, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x44, 0x62, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x44, 0x62, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f,
+	0x6d, 0x65, 0x6d, 0x64, 0x62, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x90, 0x01, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72,
 	0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x0f, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x01, 0x2a, 0x12, 0xb3, 0x01, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
-	0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x2d, 0x76, 0x61, 0x72, 0x5a, 0x15, 0x22, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2d, 0x76, 0x61, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x90,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61,
-	0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x5a, 0x15, 0x22, 0x10, 0x2f, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0x8e, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x0f, 0x2f, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5a, 0x14, 0x22, 0x0f,
+	0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a,
+	0x01, 0x2a, 0x12, 0xaa, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3d, 0x12, 0x1a, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x2d, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x5a, 0x1f,
+	0x22, 0x1a, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x2d, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0xb3, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x12, 0x29, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x2f,
+	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x5a, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61,
 	0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x2f, 0x75, 0x6e, 0x69,
-	0x6f, 0x6e, 0x5a, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f,
-	0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x3a,
-	0x01, 0x2a, 0x12, 0xcb, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x12, 0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x1d, 0x2f,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x61, 0x74, 0x65, 0x2f,
-	0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5a, 0x22, 0x22, 0x1d,
-	0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x61, 0x74, 0x65,
-	0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a,
-	0x12, 0xbe, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x6a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x64, 0x12, 0x15, 0x2f,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2d, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x5a, 0x1a, 0x22, 0x15, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x01, 0x2a,
-	0x5a, 0x15, 0x12, 0x13, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2f, 0x61, 0x6c, 0x6c, 0x5a, 0x18, 0x22, 0x13, 0x2f, 0x73, 0x74, 0x61, 0x74,
-	0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x61, 0x6c, 0x6c, 0x3a, 0x01,
-	0x2a, 0x12, 0x8c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56,
-	0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64,
-	0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x0f, 0x2f, 0x73, 0x74, 0x61, 0x74,
-	0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x01, 0x2a,
-	0x12, 0x86, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0xcb, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43,
+	0x12, 0x1d, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5a,
+	0x22, 0x22, 0x1d, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64,
+	0x61, 0x74, 0x65, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0xbe, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
-	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x25, 0x12, 0x0e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f,
-	0x70, 0x61, 0x74, 0x68, 0x5a, 0x13, 0x22, 0x0e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61,
-	0x72, 0x2f, 0x70, 0x61, 0x74, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x12, 0x21, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x6a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x64,
+	0x12, 0x15, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61,
+	0x72, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5a, 0x1a, 0x22, 0x15, 0x2f, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x3a, 0x01, 0x2a, 0x5a, 0x15, 0x12, 0x13, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x61, 0x6c, 0x6c, 0x5a, 0x18, 0x22, 0x13, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x61, 0x6c,
+	0x6c, 0x3a, 0x01, 0x2a, 0x12, 0x8c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x6f, 0x64, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x0f, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5a, 0x14, 0x22,
+	0x0f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x56, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x0e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76,
+	0x61, 0x72, 0x2f, 0x70, 0x61, 0x74, 0x68, 0x5a, 0x13, 0x22, 0x0e, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x2d, 0x76, 0x61, 0x72, 0x2f, 0x70, 0x61, 0x74, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x87, 0x01, 0x0a,
+	0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x12, 0x21,
 	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x10, 0x2f, 0x73, 0x74, 0x61,
-	0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5a, 0x15, 0x22, 0x10,
-	0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x3a, 0x01, 0x2a, 0x12, 0x95, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56,
-	0x61, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56,
-	0x61, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b,
-	0x12, 0x11, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x73, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x5a, 0x16, 0x22, 0x11, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72,
-	0x2f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x3a, 0x01, 0x2a, 0x42, 0x09, 0x5a, 0x07, 0x2e,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x10, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5a,
+	0x15, 0x22, 0x10, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x95, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2b, 0x12, 0x11, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5a, 0x16, 0x22, 0x11, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d,
+	0x76, 0x61, 0x72, 0x2f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x3a, 0x01, 0x2a, 0x42, 0x09,
+	0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var file_mixer_proto_goTypes = []interface{}{
@@ -344,127 +398,151 @@ var file_mixer_proto_goTypes = []interface{}{
 	(*GetPropertyLabelsRequest)(nil),            // 1: datacommons.GetPropertyLabelsRequest
 	(*GetPropertyValuesRequest)(nil),            // 2: datacommons.GetPropertyValuesRequest
 	(*GetTriplesRequest)(nil),                   // 3: datacommons.GetTriplesRequest
-	(*GetPlacesInRequest)(nil),                  // 4: datacommons.GetPlacesInRequest
-	(*GetStatsRequest)(nil),                     // 5: datacommons.GetStatsRequest
-	(*GetStatSetSeriesRequest)(nil),             // 6: datacommons.GetStatSetSeriesRequest
-	(*GetStatValueRequest)(nil),                 // 7: datacommons.GetStatValueRequest
-	(*GetStatSeriesRequest)(nil),                // 8: datacommons.GetStatSeriesRequest
-	(*GetStatAllRequest)(nil),                   // 9: datacommons.GetStatAllRequest
-	(*GetStatSetWithinPlaceRequest)(nil),        // 10: datacommons.GetStatSetWithinPlaceRequest
-	(*GetStatSetRequest)(nil),                   // 11: datacommons.GetStatSetRequest
-	(*GetStatSetSeriesWithinPlaceRequest)(nil),  // 12: datacommons.GetStatSetSeriesWithinPlaceRequest
-	(*GetLocationsRankingsRequest)(nil),         // 13: datacommons.GetLocationsRankingsRequest
-	(*GetRelatedLocationsRequest)(nil),          // 14: datacommons.GetRelatedLocationsRequest
-	(*GetPlacePageDataRequest)(nil),             // 15: datacommons.GetPlacePageDataRequest
-	(*GetBioPageDataRequest)(nil),               // 16: datacommons.GetBioPageDataRequest
-	(*TranslateRequest)(nil),                    // 17: datacommons.TranslateRequest
-	(*SearchRequest)(nil),                       // 18: datacommons.SearchRequest
-	(*GetVersionRequest)(nil),                   // 19: datacommons.GetVersionRequest
-	(*GetPlaceStatsVarRequest)(nil),             // 20: datacommons.GetPlaceStatsVarRequest
-	(*GetPlaceStatVarsRequest)(nil),             // 21: datacommons.GetPlaceStatVarsRequest
-	(*GetPlaceMetadataRequest)(nil),             // 22: datacommons.GetPlaceMetadataRequest
-	(*GetPlaceStatVarsUnionRequest)(nil),        // 23: datacommons.GetPlaceStatVarsUnionRequest
-	(*GetPlaceStatDateWithinPlaceRequest)(nil),  // 24: datacommons.GetPlaceStatDateWithinPlaceRequest
-	(*GetStatVarGroupRequest)(nil),              // 25: datacommons.GetStatVarGroupRequest
-	(*GetStatVarGroupNodeRequest)(nil),          // 26: datacommons.GetStatVarGroupNodeRequest
-	(*GetStatVarPathRequest)(nil),               // 27: datacommons.GetStatVarPathRequest
-	(*SearchStatVarRequest)(nil),                // 28: datacommons.SearchStatVarRequest
-	(*GetStatVarSummaryRequest)(nil),            // 29: datacommons.GetStatVarSummaryRequest
-	(*QueryResponse)(nil),                       // 30: datacommons.QueryResponse
-	(*GetPropertyLabelsResponse)(nil),           // 31: datacommons.GetPropertyLabelsResponse
-	(*GetPropertyValuesResponse)(nil),           // 32: datacommons.GetPropertyValuesResponse
-	(*GetTriplesResponse)(nil),                  // 33: datacommons.GetTriplesResponse
-	(*GetPlacesInResponse)(nil),                 // 34: datacommons.GetPlacesInResponse
-	(*GetStatsResponse)(nil),                    // 35: datacommons.GetStatsResponse
-	(*GetStatSetSeriesResponse)(nil),            // 36: datacommons.GetStatSetSeriesResponse
-	(*GetStatValueResponse)(nil),                // 37: datacommons.GetStatValueResponse
-	(*GetStatSeriesResponse)(nil),               // 38: datacommons.GetStatSeriesResponse
-	(*GetStatAllResponse)(nil),                  // 39: datacommons.GetStatAllResponse
-	(*GetStatSetResponse)(nil),                  // 40: datacommons.GetStatSetResponse
-	(*GetStatSetAllResponse)(nil),               // 41: datacommons.GetStatSetAllResponse
-	(*GetLocationsRankingsResponse)(nil),        // 42: datacommons.GetLocationsRankingsResponse
-	(*GetRelatedLocationsResponse)(nil),         // 43: datacommons.GetRelatedLocationsResponse
-	(*GetPlacePageDataResponse)(nil),            // 44: datacommons.GetPlacePageDataResponse
-	(*GraphNodes)(nil),                          // 45: datacommons.GraphNodes
-	(*TranslateResponse)(nil),                   // 46: datacommons.TranslateResponse
-	(*SearchResponse)(nil),                      // 47: datacommons.SearchResponse
-	(*GetVersionResponse)(nil),                  // 48: datacommons.GetVersionResponse
-	(*GetPlaceStatsVarResponse)(nil),            // 49: datacommons.GetPlaceStatsVarResponse
-	(*GetPlaceStatVarsResponse)(nil),            // 50: datacommons.GetPlaceStatVarsResponse
-	(*GetPlaceMetadataResponse)(nil),            // 51: datacommons.GetPlaceMetadataResponse
-	(*GetPlaceStatVarsUnionResponse)(nil),       // 52: datacommons.GetPlaceStatVarsUnionResponse
-	(*GetPlaceStatDateWithinPlaceResponse)(nil), // 53: datacommons.GetPlaceStatDateWithinPlaceResponse
-	(*StatVarGroups)(nil),                       // 54: datacommons.StatVarGroups
-	(*StatVarGroupNode)(nil),                    // 55: datacommons.StatVarGroupNode
-	(*GetStatVarPathResponse)(nil),              // 56: datacommons.GetStatVarPathResponse
-	(*SearchStatVarResponse)(nil),               // 57: datacommons.SearchStatVarResponse
-	(*GetStatVarSummaryResponse)(nil),           // 58: datacommons.GetStatVarSummaryResponse
+	(*ResolveRequest)(nil),                      // 4: datacommons.ResolveRequest
+	(*GetPlacesInRequest)(nil),                  // 5: datacommons.GetPlacesInRequest
+	(*GetPlacesInAreaRequest)(nil),              // 6: datacommons.GetPlacesInAreaRequest
+	(*GetStatsRequest)(nil),                     // 7: datacommons.GetStatsRequest
+	(*GetStatSetSeriesRequest)(nil),             // 8: datacommons.GetStatSetSeriesRequest
+	(*GetStatValueRequest)(nil),                 // 9: datacommons.GetStatValueRequest
+	(*GetStatSeriesRequest)(nil),                // 10: datacommons.GetStatSeriesRequest
+	(*GetStatAllRequest)(nil),                   // 11: datacommons.GetStatAllRequest
+	(*GetStatSetWithinPlaceRequest)(nil),        // 12: datacommons.GetStatSetWithinPlaceRequest
+	(*GetStatDistributionRequest)(nil),          // 13: datacommons.GetStatDistributionRequest
+	(*GetStatCorrelationRequest)(nil),           // 14: datacommons.GetStatCorrelationRequest
+	(*GetStatSetRequest)(nil),                   // 15: datacommons.GetStatSetRequest
+	(*GetStatSetSeriesWithinPlaceRequest)(nil),  // 16: datacommons.GetStatSetSeriesWithinPlaceRequest
+	(*GetLocationsRankingsRequest)(nil),         // 17: datacommons.GetLocationsRankingsRequest
+	(*GetRelatedLocationsRequest)(nil),          // 18: datacommons.GetRelatedLocationsRequest
+	(*GetPlacePageDataRequest)(nil),             // 19: datacommons.GetPlacePageDataRequest
+	(*GetBioPageDataRequest)(nil),               // 20: datacommons.GetBioPageDataRequest
+	(*TranslateRequest)(nil),                    // 21: datacommons.TranslateRequest
+	(*SearchRequest)(nil),                       // 22: datacommons.SearchRequest
+	(*GetVersionRequest)(nil),                   // 23: datacommons.GetVersionRequest
+	(*GetMemDbStatsRequest)(nil),                // 24: datacommons.GetMemDbStatsRequest
+	(*GetPlaceStatsVarRequest)(nil),             // 25: datacommons.GetPlaceStatsVarRequest
+	(*GetPlaceStatVarsRequest)(nil),             // 26: datacommons.GetPlaceStatVarsRequest
+	(*GetPlaceMetadataRequest)(nil),             // 27: datacommons.GetPlaceMetadataRequest
+	(*ResolveCoordinatesRequest)(nil),           // 28: datacommons.ResolveCoordinatesRequest
+	(*GetPlaceStatVarsUnionRequest)(nil),        // 29: datacommons.GetPlaceStatVarsUnionRequest
+	(*GetPlaceStatDateWithinPlaceRequest)(nil),  // 30: datacommons.GetPlaceStatDateWithinPlaceRequest
+	(*GetStatVarGroupRequest)(nil),              // 31: datacommons.GetStatVarGroupRequest
+	(*GetStatVarGroupNodeRequest)(nil),          // 32: datacommons.GetStatVarGroupNodeRequest
+	(*GetStatVarPathRequest)(nil),               // 33: datacommons.GetStatVarPathRequest
+	(*SearchStatVarRequest)(nil),                // 34: datacommons.SearchStatVarRequest
+	(*GetStatVarSummaryRequest)(nil),            // 35: datacommons.GetStatVarSummaryRequest
+	(*QueryResponse)(nil),                       // 36: datacommons.QueryResponse
+	(*GetPropertyLabelsResponse)(nil),           // 37: datacommons.GetPropertyLabelsResponse
+	(*GetPropertyValuesResponse)(nil),           // 38: datacommons.GetPropertyValuesResponse
+	(*GetTriplesResponse)(nil),                  // 39: datacommons.GetTriplesResponse
+	(*ResolveResponse)(nil),                     // 40: datacommons.ResolveResponse
+	(*GetPlacesInResponse)(nil),                 // 41: datacommons.GetPlacesInResponse
+	(*GetPlacesInAreaResponse)(nil),             // 42: datacommons.GetPlacesInAreaResponse
+	(*GetStatsResponse)(nil),                    // 43: datacommons.GetStatsResponse
+	(*GetStatSetSeriesResponse)(nil),            // 44: datacommons.GetStatSetSeriesResponse
+	(*GetStatValueResponse)(nil),                // 45: datacommons.GetStatValueResponse
+	(*GetStatSeriesResponse)(nil),               // 46: datacommons.GetStatSeriesResponse
+	(*GetStatAllResponse)(nil),                  // 47: datacommons.GetStatAllResponse
+	(*GetStatSetResponse)(nil),                  // 48: datacommons.GetStatSetResponse
+	(*GetStatSetAllResponse)(nil),               // 49: datacommons.GetStatSetAllResponse
+	(*GetStatDistributionResponse)(nil),         // 50: datacommons.GetStatDistributionResponse
+	(*GetStatCorrelationResponse)(nil),          // 51: datacommons.GetStatCorrelationResponse
+	(*GetLocationsRankingsResponse)(nil),        // 52: datacommons.GetLocationsRankingsResponse
+	(*GetRelatedLocationsResponse)(nil),         // 53: datacommons.GetRelatedLocationsResponse
+	(*GetPlacePageDataResponse)(nil),            // 54: datacommons.GetPlacePageDataResponse
+	(*GraphNodes)(nil),                          // 55: datacommons.GraphNodes
+	(*TranslateResponse)(nil),                   // 56: datacommons.TranslateResponse
+	(*SearchResponse)(nil),                      // 57: datacommons.SearchResponse
+	(*GetVersionResponse)(nil),                  // 58: datacommons.GetVersionResponse
+	(*GetMemDbStatsResponse)(nil),               // 59: datacommons.GetMemDbStatsResponse
+	(*GetPlaceStatsVarResponse)(nil),            // 60: datacommons.GetPlaceStatsVarResponse
+	(*GetPlaceStatVarsResponse)(nil),            // 61: datacommons.GetPlaceStatVarsResponse
+	(*GetPlaceMetadataResponse)(nil),            // 62: datacommons.GetPlaceMetadataResponse
+	(*ResolveCoordinatesResponse)(nil),          // 63: datacommons.ResolveCoordinatesResponse
+	(*GetPlaceStatVarsUnionResponse)(nil),       // 64: datacommons.GetPlaceStatVarsUnionResponse
+	(*GetPlaceStatDateWithinPlaceResponse)(nil), // 65: datacommons.GetPlaceStatDateWithinPlaceResponse
+	(*StatVarGroups)(nil),                       // 66: datacommons.StatVarGroups
+	(*StatVarGroupNode)(nil),                    // 67: datacommons.StatVarGroupNode
+	(*GetStatVarPathResponse)(nil),              // 68: datacommons.GetStatVarPathResponse
+	(*SearchStatVarResponse)(nil),               // 69: datacommons.SearchStatVarResponse
+	(*GetStatVarSummaryResponse)(nil),           // 70: datacommons.GetStatVarSummaryResponse
 }
 var file_mixer_proto_depIdxs = []int32{
 	0,  // 0: datacommons.Mixer.Query:input_type -> datacommons.QueryRequest
 	1,  // 1: datacommons.Mixer.GetPropertyLabels:input_type -> datacommons.GetPropertyLabelsRequest
 	2,  // 2: datacommons.Mixer.GetPropertyValues:input_type -> datacommons.GetPropertyValuesRequest
 	3,  // 3: datacommons.Mixer.GetTriples:input_type -> datacommons.GetTriplesRequest
-	4,  // 4: datacommons.Mixer.GetPlacesIn:input_type -> datacommons.GetPlacesInRequest
-	5,  // 5: datacommons.Mixer.GetStats:input_type -> datacommons.GetStatsRequest
-	6,  // 6: datacommons.Mixer.GetStatSetSeries:input_type -> datacommons.GetStatSetSeriesRequest
-	7,  // 7: datacommons.Mixer.GetStatValue:input_type -> datacommons.GetStatValueRequest
-	8,  // 8: datacommons.Mixer.GetStatSeries:input_type -> datacommons.GetStatSeriesRequest
-	9,  // 9: datacommons.Mixer.GetStatAll:input_type -> datacommons.GetStatAllRequest
-	10, // 10: datacommons.Mixer.GetStatSetWithinPlace:input_type -> datacommons.GetStatSetWithinPlaceRequest
-	10, // 11: datacommons.Mixer.GetStatSetWithinPlaceAll:input_type -> datacommons.GetStatSetWithinPlaceRequest
-	11, // 12: datacommons.Mixer.GetStatSet:input_type -> datacommons.GetStatSetRequest
-	12, // 13: datacommons.Mixer.GetStatSetSeriesWithinPlace:input_type -> datacommons.GetStatSetSeriesWithinPlaceRequest
-	13, // 14: datacommons.Mixer.GetLocationsRankings:input_type -> datacommons.GetLocationsRankingsRequest
-	14, // 15: datacommons.Mixer.GetRelatedLocations:input_type -> datacommons.GetRelatedLocationsRequest
-	15, // 16: datacommons.Mixer.GetPlacePageData:input_type -> datacommons.GetPlacePageDataRequest
-	16, // 17: datacommons.Mixer.GetBioPageData:input_type -> datacommons.GetBioPageDataRequest
-	17, // 18: datacommons.Mixer.Translate:input_type -> datacommons.TranslateRequest
-	18, // 19: datacommons.Mixer.Search:input_type -> datacommons.SearchRequest
-	19, // 20: datacommons.Mixer.GetVersion:input_type -> datacommons.GetVersionRequest
-	20, // 21: datacommons.Mixer.GetPlaceStatsVar:input_type -> datacommons.GetPlaceStatsVarRequest
-	21, // 22: datacommons.Mixer.GetPlaceStatVars:input_type -> datacommons.GetPlaceStatVarsRequest
-	22, // 23: datacommons.Mixer.GetPlaceMetadata:input_type -> datacommons.GetPlaceMetadataRequest
-	23, // 24: datacommons.Mixer.GetPlaceStatVarsUnionV1:input_type -> datacommons.GetPlaceStatVarsUnionRequest
-	24, // 25: datacommons.Mixer.GetPlaceStatDateWithinPlace:input_type -> datacommons.GetPlaceStatDateWithinPlaceRequest
-	25, // 26: datacommons.Mixer.GetStatVarGroup:input_type -> datacommons.GetStatVarGroupRequest
-	26, // 27: datacommons.Mixer.GetStatVarGroupNode:input_type -> datacommons.GetStatVarGroupNodeRequest
-	27, // 28: datacommons.Mixer.GetStatVarPath:input_type -> datacommons.GetStatVarPathRequest
-	28, // 29: datacommons.Mixer.SearchStatVar:input_type -> datacommons.SearchStatVarRequest
-	29, // 30: datacommons.Mixer.GetStatVarSummary:input_type -> datacommons.GetStatVarSummaryRequest
-	30, // 31: datacommons.Mixer.Query:output_type -> datacommons.QueryResponse
-	31, // 32: datacommons.Mixer.GetPropertyLabels:output_type -> datacommons.GetPropertyLabelsResponse
-	32, // 33: datacommons.Mixer.GetPropertyValues:output_type -> datacommons.GetPropertyValuesResponse
-	33, // 34: datacommons.Mixer.GetTriples:output_type -> datacommons.GetTriplesResponse
-	34, // 35: datacommons.Mixer.GetPlacesIn:output_type -> datacommons.GetPlacesInResponse
-	35, // 36: datacommons.Mixer.GetStats:output_type -> datacommons.GetStatsResponse
-	36, // 37: datacommons.Mixer.GetStatSetSeries:output_type -> datacommons.GetStatSetSeriesResponse
-	37, // 38: datacommons.Mixer.GetStatValue:output_type -> datacommons.GetStatValueResponse
-	38, // 39: datacommons.Mixer.GetStatSeries:output_type -> datacommons.GetStatSeriesResponse
-	39, // 40: datacommons.Mixer.GetStatAll:output_type -> datacommons.GetStatAllResponse
-	40, // 41: datacommons.Mixer.GetStatSetWithinPlace:output_type -> datacommons.GetStatSetResponse
-	41, // 42: datacommons.Mixer.GetStatSetWithinPlaceAll:output_type -> datacommons.GetStatSetAllResponse
-	40, // 43: datacommons.Mixer.GetStatSet:output_type -> datacommons.GetStatSetResponse
-	36, // 44: datacommons.Mixer.GetStatSetSeriesWithinPlace:output_type -> datacommons.GetStatSetSeriesResponse
-	42, // 45: datacommons.Mixer.GetLocationsRankings:output_type -> datacommons.GetLocationsRankingsResponse
-	43, // 46: datacommons.Mixer.GetRelatedLocations:output_type -> datacommons.GetRelatedLocationsResponse
-	44, // 47: datacommons.Mixer.GetPlacePageData:output_type -> datacommons.GetPlacePageDataResponse
-	45, // 48: datacommons.Mixer.GetBioPageData:output_type -> datacommons.GraphNodes
-	46, // 49: datacommons.Mixer.Translate:output_type -> datacommons.TranslateResponse
-	47, // 50: datacommons.Mixer.Search:output_type -> datacommons.SearchResponse
-	48, // 51: datacommons.Mixer.GetVersion:output_type -> datacommons.GetVersionResponse
-	49, // 52: datacommons.Mixer.GetPlaceStatsVar:output_type -> datacommons.GetPlaceStatsVarResponse
-	50, // 53: datacommons.Mixer.GetPlaceStatVars:output_type -> datacommons.GetPlaceStatVarsResponse
-	51, // 54: datacommons.Mixer.GetPlaceMetadata:output_type -> datacommons.GetPlaceMetadataResponse
-	52, // 55: datacommons.Mixer.GetPlaceStatVarsUnionV1:output_type -> datacommons.GetPlaceStatVarsUnionResponse
-	53, // 56: datacommons.Mixer.GetPlaceStatDateWithinPlace:output_type -> datacommons.GetPlaceStatDateWithinPlaceResponse
-	54, // 57: datacommons.Mixer.GetStatVarGroup:output_type -> datacommons.StatVarGroups
-	55, // 58: datacommons.Mixer.GetStatVarGroupNode:output_type -> datacommons.StatVarGroupNode
-	56, // 59: datacommons.Mixer.GetStatVarPath:output_type -> datacommons.GetStatVarPathResponse
-	57, // 60: datacommons.Mixer.SearchStatVar:output_type -> datacommons.SearchStatVarResponse
-	58, // 61: datacommons.Mixer.GetStatVarSummary:output_type -> datacommons.GetStatVarSummaryResponse
-	31, // [31:62] is the sub-list for method output_type
-	0,  // [0:31] is the sub-list for method input_type
+	4,  // 4: datacommons.Mixer.Resolve:input_type -> datacommons.ResolveRequest
+	5,  // 5: datacommons.Mixer.GetPlacesIn:input_type -> datacommons.GetPlacesInRequest
+	6,  // 6: datacommons.Mixer.GetPlacesInArea:input_type -> datacommons.GetPlacesInAreaRequest
+	7,  // 7: datacommons.Mixer.GetStats:input_type -> datacommons.GetStatsRequest
+	8,  // 8: datacommons.Mixer.GetStatSetSeries:input_type -> datacommons.GetStatSetSeriesRequest
+	9,  // 9: datacommons.Mixer.GetStatValue:input_type -> datacommons.GetStatValueRequest
+	10, // 10: datacommons.Mixer.GetStatSeries:input_type -> datacommons.GetStatSeriesRequest
+	11, // 11: datacommons.Mixer.GetStatAll:input_type -> datacommons.GetStatAllRequest
+	12, // 12: datacommons.Mixer.GetStatSetWithinPlace:input_type -> datacommons.GetStatSetWithinPlaceRequest
+	12, // 13: datacommons.Mixer.GetStatSetWithinPlaceAll:input_type -> datacommons.GetStatSetWithinPlaceRequest
+	13, // 14: datacommons.Mixer.GetStatDistribution:input_type -> datacommons.GetStatDistributionRequest
+	14, // 15: datacommons.Mixer.GetStatCorrelation:input_type -> datacommons.GetStatCorrelationRequest
+	15, // 16: datacommons.Mixer.GetStatSet:input_type -> datacommons.GetStatSetRequest
+	16, // 17: datacommons.Mixer.GetStatSetSeriesWithinPlace:input_type -> datacommons.GetStatSetSeriesWithinPlaceRequest
+	17, // 18: datacommons.Mixer.GetLocationsRankings:input_type -> datacommons.GetLocationsRankingsRequest
+	18, // 19: datacommons.Mixer.GetRelatedLocations:input_type -> datacommons.GetRelatedLocationsRequest
+	19, // 20: datacommons.Mixer.GetPlacePageData:input_type -> datacommons.GetPlacePageDataRequest
+	20, // 21: datacommons.Mixer.GetBioPageData:input_type -> datacommons.GetBioPageDataRequest
+	21, // 22: datacommons.Mixer.Translate:input_type -> datacommons.TranslateRequest
+	22, // 23: datacommons.Mixer.Search:input_type -> datacommons.SearchRequest
+	23, // 24: datacommons.Mixer.GetVersion:input_type -> datacommons.GetVersionRequest
+	24, // 25: datacommons.Mixer.GetMemDbStats:input_type -> datacommons.GetMemDbStatsRequest
+	25, // 26: datacommons.Mixer.GetPlaceStatsVar:input_type -> datacommons.GetPlaceStatsVarRequest
+	26, // 27: datacommons.Mixer.GetPlaceStatVars:input_type -> datacommons.GetPlaceStatVarsRequest
+	27, // 28: datacommons.Mixer.GetPlaceMetadata:input_type -> datacommons.GetPlaceMetadataRequest
+	28, // 29: datacommons.Mixer.ResolveCoordinates:input_type -> datacommons.ResolveCoordinatesRequest
+	29, // 30: datacommons.Mixer.GetPlaceStatVarsUnionV1:input_type -> datacommons.GetPlaceStatVarsUnionRequest
+	30, // 31: datacommons.Mixer.GetPlaceStatDateWithinPlace:input_type -> datacommons.GetPlaceStatDateWithinPlaceRequest
+	31, // 32: datacommons.Mixer.GetStatVarGroup:input_type -> datacommons.GetStatVarGroupRequest
+	32, // 33: datacommons.Mixer.GetStatVarGroupNode:input_type -> datacommons.GetStatVarGroupNodeRequest
+	33, // 34: datacommons.Mixer.GetStatVarPath:input_type -> datacommons.GetStatVarPathRequest
+	34, // 35: datacommons.Mixer.SearchStatVar:input_type -> datacommons.SearchStatVarRequest
+	35, // 36: datacommons.Mixer.GetStatVarSummary:input_type -> datacommons.GetStatVarSummaryRequest
+	36, // 37: datacommons.Mixer.Query:output_type -> datacommons.QueryResponse
+	37, // 38: datacommons.Mixer.GetPropertyLabels:output_type -> datacommons.GetPropertyLabelsResponse
+	38, // 39: datacommons.Mixer.GetPropertyValues:output_type -> datacommons.GetPropertyValuesResponse
+	39, // 40: datacommons.Mixer.GetTriples:output_type -> datacommons.GetTriplesResponse
+	40, // 41: datacommons.Mixer.Resolve:output_type -> datacommons.ResolveResponse
+	41, // 42: datacommons.Mixer.GetPlacesIn:output_type -> datacommons.GetPlacesInResponse
+	42, // 43: datacommons.Mixer.GetPlacesInArea:output_type -> datacommons.GetPlacesInAreaResponse
+	43, // 44: datacommons.Mixer.GetStats:output_type -> datacommons.GetStatsResponse
+	44, // 45: datacommons.Mixer.GetStatSetSeries:output_type -> datacommons.GetStatSetSeriesResponse
+	45, // 46: datacommons.Mixer.GetStatValue:output_type -> datacommons.GetStatValueResponse
+	46, // 47: datacommons.Mixer.GetStatSeries:output_type -> datacommons.GetStatSeriesResponse
+	47, // 48: datacommons.Mixer.GetStatAll:output_type -> datacommons.GetStatAllResponse
+	48, // 49: datacommons.Mixer.GetStatSetWithinPlace:output_type -> datacommons.GetStatSetResponse
+	49, // 50: datacommons.Mixer.GetStatSetWithinPlaceAll:output_type -> datacommons.GetStatSetAllResponse
+	50, // 51: datacommons.Mixer.GetStatDistribution:output_type -> datacommons.GetStatDistributionResponse
+	51, // 52: datacommons.Mixer.GetStatCorrelation:output_type -> datacommons.GetStatCorrelationResponse
+	48, // 53: datacommons.Mixer.GetStatSet:output_type -> datacommons.GetStatSetResponse
+	44, // 54: datacommons.Mixer.GetStatSetSeriesWithinPlace:output_type -> datacommons.GetStatSetSeriesResponse
+	52, // 55: datacommons.Mixer.GetLocationsRankings:output_type -> datacommons.GetLocationsRankingsResponse
+	53, // 56: datacommons.Mixer.GetRelatedLocations:output_type -> datacommons.GetRelatedLocationsResponse
+	54, // 57: datacommons.Mixer.GetPlacePageData:output_type -> datacommons.GetPlacePageDataResponse
+	55, // 58: datacommons.Mixer.GetBioPageData:output_type -> datacommons.GraphNodes
+	56, // 59: datacommons.Mixer.Translate:output_type -> datacommons.TranslateResponse
+	57, // 60: datacommons.Mixer.Search:output_type -> datacommons.SearchResponse
+	58, // 61: datacommons.Mixer.GetVersion:output_type -> datacommons.GetVersionResponse
+	59, // 62: datacommons.Mixer.GetMemDbStats:output_type -> datacommons.GetMemDbStatsResponse
+	60, // 63: datacommons.Mixer.GetPlaceStatsVar:output_type -> datacommons.GetPlaceStatsVarResponse
+	61, // 64: datacommons.Mixer.GetPlaceStatVars:output_type -> datacommons.GetPlaceStatVarsResponse
+	62, // 65: datacommons.Mixer.GetPlaceMetadata:output_type -> datacommons.GetPlaceMetadataResponse
+	63, // 66: datacommons.Mixer.ResolveCoordinates:output_type -> datacommons.ResolveCoordinatesResponse
+	64, // 67: datacommons.Mixer.GetPlaceStatVarsUnionV1:output_type -> datacommons.GetPlaceStatVarsUnionResponse
+	65, // 68: datacommons.Mixer.GetPlaceStatDateWithinPlace:output_type -> datacommons.GetPlaceStatDateWithinPlaceResponse
+	66, // 69: datacommons.Mixer.GetStatVarGroup:output_type -> datacommons.StatVarGroups
+	67, // 70: datacommons.Mixer.GetStatVarGroupNode:output_type -> datacommons.StatVarGroupNode
+	68, // 71: datacommons.Mixer.GetStatVarPath:output_type -> datacommons.GetStatVarPathResponse
+	69, // 72: datacommons.Mixer.SearchStatVar:output_type -> datacommons.SearchStatVarResponse
+	70, // 73: datacommons.Mixer.GetStatVarSummary:output_type -> datacommons.GetStatVarSummaryResponse
+	37, // [37:74] is the sub-list for method output_type
+	0,  // [0:37] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -25,8 +25,13 @@ type MixerClient interface {
 	GetPropertyValues(ctx context.Context, in *GetPropertyValuesRequest, opts ...grpc.CallOption) (*GetPropertyValuesResponse, error)
 	// Fetch triples that have the given nodes as subject or object.
 	GetTriples(ctx context.Context, in *GetTriplesRequest, opts ...grpc.CallOption) (*GetTriplesResponse, error)
+	// Resolve external IDs or place names to dcids.
+	Resolve(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error)
 	// Get places contained in parent places.
 	GetPlacesIn(ctx context.Context, in *GetPlacesInRequest, opts ...grpc.CallOption) (*GetPlacesInResponse, error)
+	// Get places of a type within a radius of a point or intersecting a
+	// bounding box.
+	GetPlacesInArea(ctx context.Context, in *GetPlacesInAreaRequest, opts ...grpc.CallOption) (*GetPlacesInAreaResponse, error)
 	// Get stats of places by StatisticalVariable. If multiple time series data
 	// are avaialable, the highest ranked one by measurement method and import
 	// will be returned.
@@ -54,6 +59,12 @@ type MixerClient interface {
 	// type. If date is not specified, the latest value of every source is
 	// returned.
 	GetStatSetWithinPlaceAll(ctx context.Context, in *GetStatSetWithinPlaceRequest, opts ...grpc.CallOption) (*GetStatSetAllResponse, error)
+	// Get the distribution of the stat values of children places of certain
+	// place type, from a single source for each stat var.
+	GetStatDistribution(ctx context.Context, in *GetStatDistributionRequest, opts ...grpc.CallOption) (*GetStatDistributionResponse, error)
+	// Get the correlation and linear fit between two stat vars across children
+	// places of certain place type.
+	GetStatCorrelation(ctx context.Context, in *GetStatCorrelationRequest, opts ...grpc.CallOption) (*GetStatCorrelationResponse, error)
 	// Get the stat value for given places and stat vars. If date is not given,
 	// then the latest value for each <place, stat var> is returned.
 	GetStatSet(ctx context.Context, in *GetStatSetRequest, opts ...grpc.CallOption) (*GetStatSetResponse, error)
@@ -70,9 +81,16 @@ type MixerClient interface {
 	// Translate Sparql Query into translation results.
 	Translate(ctx context.Context, in *TranslateRequest, opts ...grpc.CallOption) (*TranslateResponse, error)
 	// Given a text search query, return all entities matching the query.
+	// When the mixer is started with a place index, the places of the types in
+	// the index are searched in the index, with autocomplete of the last word and
+	// typo tolerance, and other entities are searched as before and returned
+	// after them.
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 	// Retrieves the version metadata.
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	// Retrieves the size of the in-memory database of private imports, for
+	// capacity planning.
+	GetMemDbStats(ctx context.Context, in *GetMemDbStatsRequest, opts ...grpc.CallOption) (*GetMemDbStatsResponse, error)
 	// Give a list of place dcids, return all the statistical variables for each
 	// place.
 	GetPlaceStatsVar(ctx context.Context, in *GetPlaceStatsVarRequest, opts ...grpc.CallOption) (*GetPlaceStatsVarResponse, error)
@@ -82,6 +100,8 @@ type MixerClient interface {
 	GetPlaceStatVars(ctx context.Context, in *GetPlaceStatVarsRequest, opts ...grpc.CallOption) (*GetPlaceStatVarsResponse, error)
 	// Give a list of place dcids, return metadata for each place.
 	GetPlaceMetadata(ctx context.Context, in *GetPlaceMetadataRequest, opts ...grpc.CallOption) (*GetPlaceMetadataResponse, error)
+	// Resolve coordinates to the places whose boundaries contain them.
+	ResolveCoordinates(ctx context.Context, in *ResolveCoordinatesRequest, opts ...grpc.CallOption) (*ResolveCoordinatesResponse, error)
 	// Given a list of place dcids, returns the union of available
 	// statistical variables for the places.
 	GetPlaceStatVarsUnionV1(ctx context.Context, in *GetPlaceStatVarsUnionRequest, opts ...grpc.CallOption) (*GetPlaceStatVarsUnionResponse, error)
@@ -146,6 +166,15 @@ func (c *mixerClient) GetTriples(ctx context.Context, in *GetTriplesRequest, opt
 	return out, nil
 }
 
+func (c *mixerClient) Resolve(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error) {
+	out := new(ResolveResponse)
+	err := c.cc.Invoke(ctx, "/datacommons.Mixer/Resolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mixerClient) GetPlacesIn(ctx context.Context, in *GetPlacesInRequest, opts ...grpc.CallOption) (*GetPlacesInResponse, error) {
 	out := new(GetPlacesInResponse)
 	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetPlacesIn", in, out, opts...)
@@ -155,6 +184,15 @@ func (c *mixerClient) GetPlacesIn(ctx context.Context, in *GetPlacesInRequest, o
 	return out, nil
 }
 
+func (c *mixerClient) GetPlacesInArea(ctx context.Context, in *GetPlacesInAreaRequest, opts ...grpc.CallOption) (*GetPlacesInAreaResponse, error) {
+	out := new(GetPlacesInAreaResponse)
+	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetPlacesInArea", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mixerClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
 	out := new(GetStatsResponse)
 	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetStats", in, out, opts...)
@@ -218,6 +256,24 @@ func (c *mixerClient) GetStatSetWithinPlaceAll(ctx context.Context, in *GetStatS
 	return out, nil
 }
 
+func (c *mixerClient) GetStatDistribution(ctx context.Context, in *GetStatDistributionRequest, opts ...grpc.CallOption) (*GetStatDistributionResponse, error) {
+	out := new(GetStatDistributionResponse)
+	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetStatDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mixerClient) GetStatCorrelation(ctx context.Context, in *GetStatCorrelationRequest, opts ...grpc.CallOption) (*GetStatCorrelationResponse, error) {
+	out := new(GetStatCorrelationResponse)
+	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetStatCorrelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mixerClient) GetStatSet(ctx context.Context, in *GetStatSetRequest, opts ...grpc.CallOption) (*GetStatSetResponse, error) {
 	out := new(GetStatSetResponse)
 	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetStatSet", in, out, opts...)
@@ -299,6 +355,15 @@ func (c *mixerClient) GetVersion(ctx context.Context, in *GetVersionRequest, opt
 	return out, nil
 }
 
+func (c *mixerClient) GetMemDbStats(ctx context.Context, in *GetMemDbStatsRequest, opts ...grpc.CallOption) (*GetMemDbStatsResponse, error) {
+	out := new(GetMemDbStatsResponse)
+	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetMemDbStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mixerClient) GetPlaceStatsVar(ctx context.Context, in *GetPlaceStatsVarRequest, opts ...grpc.CallOption) (*GetPlaceStatsVarResponse, error) {
 	out := new(GetPlaceStatsVarResponse)
 	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetPlaceStatsVar", in, out, opts...)
@@ -326,6 +391,15 @@ func (c *mixerClient) GetPlaceMetadata(ctx context.Context, in *GetPlaceMetadata
 	return out, nil
 }
 
+func (c *mixerClient) ResolveCoordinates(ctx context.Context, in *ResolveCoordinatesRequest, opts ...grpc.CallOption) (*ResolveCoordinatesResponse, error) {
+	out := new(ResolveCoordinatesResponse)
+	err := c.cc.Invoke(ctx, "/datacommons.Mixer/ResolveCoordinates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mixerClient) GetPlaceStatVarsUnionV1(ctx context.Context, in *GetPlaceStatVarsUnionRequest, opts ...grpc.CallOption) (*GetPlaceStatVarsUnionResponse, error) {
 	out := new(GetPlaceStatVarsUnionResponse)
 	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetPlaceStatVarsUnionV1", in, out, opts...)
@@ -401,8 +475,13 @@ type MixerServer interface {
 	GetPropertyValues(context.Context, *GetPropertyValuesRequest) (*GetPropertyValuesResponse, error)
 	// Fetch triples that have the given nodes as subject or object.
 	GetTriples(context.Context, *GetTriplesRequest) (*GetTriplesResponse, error)
+	// Resolve external IDs or place names to dcids.
+	Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error)
 	// Get places contained in parent places.
 	GetPlacesIn(context.Context, *GetPlacesInRequest) (*GetPlacesInResponse, error)
+	// Get places of a type within a radius of a point or intersecting a
+	// bounding box.
+	GetPlacesInArea(context.Context, *GetPlacesInAreaRequest) (*GetPlacesInAreaResponse, error)
 	// Get stats of places by StatisticalVariable. If multiple time series data
 	// are avaialable, the highest ranked one by measurement method and import
 	// will be returned.
@@ -430,6 +509,12 @@ type MixerServer interface {
 	// type. If date is not specified, the latest value of every source is
 	// returned.
 	GetStatSetWithinPlaceAll(context.Context, *GetStatSetWithinPlaceRequest) (*GetStatSetAllResponse, error)
+	// Get the distribution of the stat values of children places of certain
+	// place type, from a single source for each stat var.
+	GetStatDistribution(context.Context, *GetStatDistributionRequest) (*GetStatDistributionResponse, error)
+	// Get the correlation and linear fit between two stat vars across children
+	// places of certain place type.
+	GetStatCorrelation(context.Context, *GetStatCorrelationRequest) (*GetStatCorrelationResponse, error)
 	// Get the stat value for given places and stat vars. If date is not given,
 	// then the latest value for each <place, stat var> is returned.
 	GetStatSet(context.Context, *GetStatSetRequest) (*GetStatSetResponse, error)
@@ -446,9 +531,16 @@ type MixerServer interface {
 	// Translate Sparql Query into translation results.
 	Translate(context.Context, *TranslateRequest) (*TranslateResponse, error)
 	// Given a text search query, return all entities matching the query.
+	// When the mixer is started with a place index, the places of the types in
+	// the index are searched in the index, with autocomplete of the last word and
+	// typo tolerance, and other entities are searched as before and returned
+	// after them.
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 	// Retrieves the version metadata.
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	// Retrieves the size of the in-memory database of private imports, for
+	// capacity planning.
+	GetMemDbStats(context.Context, *GetMemDbStatsRequest) (*GetMemDbStatsResponse, error)
 	// Give a list of place dcids, return all the statistical variables for each
 	// place.
 	GetPlaceStatsVar(context.Context, *GetPlaceStatsVarRequest) (*GetPlaceStatsVarResponse, error)
@@ -458,6 +550,8 @@ type MixerServer interface {
 	GetPlaceStatVars(context.Context, *GetPlaceStatVarsRequest) (*GetPlaceStatVarsResponse, error)
 	// Give a list of place dcids, return metadata for each place.
 	GetPlaceMetadata(context.Context, *GetPlaceMetadataRequest) (*GetPlaceMetadataResponse, error)
+	// Resolve coordinates to the places whose boundaries contain them.
+	ResolveCoordinates(context.Context, *ResolveCoordinatesRequest) (*ResolveCoordinatesResponse, error)
 	// Given a list of place dcids, returns the union of available
 	// statistical variables for the places.
 	GetPlaceStatVarsUnionV1(context.Context, *GetPlaceStatVarsUnionRequest) (*GetPlaceStatVarsUnionResponse, error)
@@ -494,9 +588,15 @@ func (*UnimplementedMixerServer) GetPropertyValues(context.Context, *GetProperty
 func (*UnimplementedMixerServer) GetTriples(context.Context, *GetTriplesRequest) (*GetTriplesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTriples not implemented")
 }
+func (*UnimplementedMixerServer) Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
+}
 func (*UnimplementedMixerServer) GetPlacesIn(context.Context, *GetPlacesInRequest) (*GetPlacesInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlacesIn not implemented")
 }
+func (*UnimplementedMixerServer) GetPlacesInArea(context.Context, *GetPlacesInAreaRequest) (*GetPlacesInAreaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlacesInArea not implemented")
+}
 func (*UnimplementedMixerServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
 }
@@ -518,6 +618,12 @@ func (*UnimplementedMixerServer) GetStatSetWithinPlace(context.Context, *GetStat
 func (*UnimplementedMixerServer) GetStatSetWithinPlaceAll(context.Context, *GetStatSetWithinPlaceRequest) (*GetStatSetAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatSetWithinPlaceAll not implemented")
 }
+func (*UnimplementedMixerServer) GetStatDistribution(context.Context, *GetStatDistributionRequest) (*GetStatDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatDistribution not implemented")
+}
+func (*UnimplementedMixerServer) GetStatCorrelation(context.Context, *GetStatCorrelationRequest) (*GetStatCorrelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatCorrelation not implemented")
+}
 func (*UnimplementedMixerServer) GetStatSet(context.Context, *GetStatSetRequest) (*GetStatSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatSet not implemented")
 }
@@ -545,6 +651,9 @@ func (*UnimplementedMixerServer) Search(context.Context, *SearchRequest) (*Searc
 func (*UnimplementedMixerServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
+func (*UnimplementedMixerServer) GetMemDbStats(context.Context, *GetMemDbStatsRequest) (*GetMemDbStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemDbStats not implemented")
+}
 func (*UnimplementedMixerServer) GetPlaceStatsVar(context.Context, *GetPlaceStatsVarRequest) (*GetPlaceStatsVarResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlaceStatsVar not implemented")
 }
@@ -554,6 +663,9 @@ func (*UnimplementedMixerServer) GetPlaceStatVars(context.Context, *GetPlaceStat
 func (*UnimplementedMixerServer) GetPlaceMetadata(context.Context, *GetPlaceMetadataRequest) (*GetPlaceMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlaceMetadata not implemented")
 }
+func (*UnimplementedMixerServer) ResolveCoordinates(context.Context, *ResolveCoordinatesRequest) (*ResolveCoordinatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCoordinates not implemented")
+}
 func (*UnimplementedMixerServer) GetPlaceStatVarsUnionV1(context.Context, *GetPlaceStatVarsUnionRequest) (*GetPlaceStatVarsUnionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlaceStatVarsUnionV1 not implemented")
 }
@@ -652,6 +764,24 @@ func _Mixer_GetTriples_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mixer_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerServer).Resolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.Mixer/Resolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerServer).Resolve(ctx, req.(*ResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mixer_GetPlacesIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPlacesInRequest)
 	if err := dec(in); err != nil {
@@ -670,6 +800,24 @@ func _Mixer_GetPlacesIn_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mixer_GetPlacesInArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlacesInAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerServer).GetPlacesInArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.Mixer/GetPlacesInArea",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerServer).GetPlacesInArea(ctx, req.(*GetPlacesInAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mixer_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStatsRequest)
 	if err := dec(in); err != nil {
@@ -796,6 +944,42 @@ func _Mixer_GetStatSetWithinPlaceAll_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mixer_GetStatDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerServer).GetStatDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.Mixer/GetStatDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerServer).GetStatDistribution(ctx, req.(*GetStatDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mixer_GetStatCorrelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatCorrelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerServer).GetStatCorrelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.Mixer/GetStatCorrelation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerServer).GetStatCorrelation(ctx, req.(*GetStatCorrelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mixer_GetStatSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStatSetRequest)
 	if err := dec(in); err != nil {
@@ -958,6 +1142,24 @@ func _Mixer_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mixer_GetMemDbStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemDbStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerServer).GetMemDbStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.Mixer/GetMemDbStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerServer).GetMemDbStats(ctx, req.(*GetMemDbStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mixer_GetPlaceStatsVar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPlaceStatsVarRequest)
 	if err := dec(in); err != nil {
@@ -1012,6 +1214,24 @@ func _Mixer_GetPlaceMetadata_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mixer_ResolveCoordinates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveCoordinatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerServer).ResolveCoordinates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.Mixer/ResolveCoordinates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerServer).ResolveCoordinates(ctx, req.(*ResolveCoordinatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mixer_GetPlaceStatVarsUnionV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPlaceStatVarsUnionRequest)
 	if err := dec(in); err != nil {
@@ -1158,10 +1378,18 @@ var _Mixer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTriples",
 			Handler:    _Mixer_GetTriples_Handler,
 		},
+		{
+			MethodName: "Resolve",
+			Handler:    _Mixer_Resolve_Handler,
+		},
 		{
 			MethodName: "GetPlacesIn",
 			Handler:    _Mixer_GetPlacesIn_Handler,
 		},
+		{
+			MethodName: "GetPlacesInArea",
+			Handler:    _Mixer_GetPlacesInArea_Handler,
+		},
 		{
 			MethodName: "GetStats",
 			Handler:    _Mixer_GetStats_Handler,
@@ -1190,6 +1418,14 @@ var _Mixer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatSetWithinPlaceAll",
 			Handler:    _Mixer_GetStatSetWithinPlaceAll_Handler,
 		},
+		{
+			MethodName: "GetStatDistribution",
+			Handler:    _Mixer_GetStatDistribution_Handler,
+		},
+		{
+			MethodName: "GetStatCorrelation",
+			Handler:    _Mixer_GetStatCorrelation_Handler,
+		},
 		{
 			MethodName: "GetStatSet",
 			Handler:    _Mixer_GetStatSet_Handler,
@@ -1226,6 +1462,10 @@ var _Mixer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersion",
 			Handler:    _Mixer_GetVersion_Handler,
 		},
+		{
+			MethodName: "GetMemDbStats",
+			Handler:    _Mixer_GetMemDbStats_Handler,
+		},
 		{
 			MethodName: "GetPlaceStatsVar",
 			Handler:    _Mixer_GetPlaceStatsVar_Handler,
@@ -1238,6 +1478,10 @@ var _Mixer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPlaceMetadata",
 			Handler:    _Mixer_GetPlaceMetadata_Handler,
 		},
+		{
+			MethodName: "ResolveCoordinates",
+			Handler:    _Mixer_ResolveCoordinates_Handler,
+		},
 		{
 			MethodName: "GetPlaceStatVarsUnionV1",
 			Handler:    _Mixer_GetPlaceStatVarsUnionV1_Handler,
@@ -15,11 +15,13 @@
 // REST API URL from the proto in this file:
 // ========================================
 //    /node/places-in
+//    /place/in-area
 //    /node/related-locations
 //    /node/ranking-locations
 //    /place/stats-var
 //    /place/stat-vars
 //    /place/metadata
+//    /place/resolve-coordinates
 //    /v1/place/stat-vars/union
 //    /place/stat/date/within-place
 // ========================================
@@ -198,6 +200,11 @@ type GetPlacesInRequest struct {
 	Dcids []string `protobuf:"bytes,1,rep,name=dcids,proto3" json:"dcids,omitempty"`
 	// The child place type.
 	PlaceType string `protobuf:"bytes,2,opt,name=place_type,json=placeType,proto3" json:"place_type,omitempty"`
+	// (Optional) Maximum number of containedInPlace levels walked from a parent
+	// place without a precomputed relation to the child place type. Defaults to
+	// 3. Places found by the walk have the path from the parent place in the
+	// payload.
+	MaxDepth int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
 }
 
 func (x *GetPlacesInRequest) Reset() {
@@ -246,6 +253,13 @@ func (x *GetPlacesInRequest) GetPlaceType() string {
 	return ""
 }
 
+func (x *GetPlacesInRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
 // Response of GetPlacesIn.
 type GetPlacesInResponse struct {
 	state         protoimpl.MessageState
@@ -295,6 +309,296 @@ func (x *GetPlacesInResponse) GetPayload() string {
 	return ""
 }
 
+// A bounding box in degrees. min_longitude is larger than max_longitude when
+// the box crosses the antimeridian.
+type BoundingBox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLatitude  float64 `protobuf:"fixed64,1,opt,name=min_latitude,json=minLatitude,proto3" json:"min_latitude,omitempty"`
+	MinLongitude float64 `protobuf:"fixed64,2,opt,name=min_longitude,json=minLongitude,proto3" json:"min_longitude,omitempty"`
+	MaxLatitude  float64 `protobuf:"fixed64,3,opt,name=max_latitude,json=maxLatitude,proto3" json:"max_latitude,omitempty"`
+	MaxLongitude float64 `protobuf:"fixed64,4,opt,name=max_longitude,json=maxLongitude,proto3" json:"max_longitude,omitempty"`
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_place_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BoundingBox) GetMinLatitude() float64 {
+	if x != nil {
+		return x.MinLatitude
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMinLongitude() float64 {
+	if x != nil {
+		return x.MinLongitude
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLatitude() float64 {
+	if x != nil {
+		return x.MaxLatitude
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLongitude() float64 {
+	if x != nil {
+		return x.MaxLongitude
+	}
+	return 0
+}
+
+// Request to get places of a type in an area, either within a radius of a
+// point or intersecting a bounding box.
+type GetPlacesInAreaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The place type.
+	PlaceType string `protobuf:"bytes,1,opt,name=place_type,json=placeType,proto3" json:"place_type,omitempty"`
+	// Center of the radius query.
+	Latitude  float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Radius in km of the radius query.
+	RadiusKm float64 `protobuf:"fixed64,4,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	// Bounding box of the bounding box query. Used instead of the radius query
+	// when set. Places intersect the box by their boundary when available, or by
+	// their location.
+	Bbox *BoundingBox `protobuf:"bytes,5,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	// (Optional) Only return places with data for all the stat vars.
+	StatVars []string `protobuf:"bytes,6,rep,name=stat_vars,json=statVars,proto3" json:"stat_vars,omitempty"`
+}
+
+func (x *GetPlacesInAreaRequest) Reset() {
+	*x = GetPlacesInAreaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_place_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlacesInAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlacesInAreaRequest) ProtoMessage() {}
+
+func (x *GetPlacesInAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlacesInAreaRequest.ProtoReflect.Descriptor instead.
+func (*GetPlacesInAreaRequest) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPlacesInAreaRequest) GetPlaceType() string {
+	if x != nil {
+		return x.PlaceType
+	}
+	return ""
+}
+
+func (x *GetPlacesInAreaRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetPlacesInAreaRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetPlacesInAreaRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *GetPlacesInAreaRequest) GetBbox() *BoundingBox {
+	if x != nil {
+		return x.Bbox
+	}
+	return nil
+}
+
+func (x *GetPlacesInAreaRequest) GetStatVars() []string {
+	if x != nil {
+		return x.StatVars
+	}
+	return nil
+}
+
+type PlaceInArea struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dcid      string  `protobuf:"bytes,1,opt,name=dcid,proto3" json:"dcid,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Distance in km to the center, set by the radius query.
+	DistanceKm float64 `protobuf:"fixed64,4,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+}
+
+func (x *PlaceInArea) Reset() {
+	*x = PlaceInArea{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_place_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceInArea) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceInArea) ProtoMessage() {}
+
+func (x *PlaceInArea) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceInArea.ProtoReflect.Descriptor instead.
+func (*PlaceInArea) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PlaceInArea) GetDcid() string {
+	if x != nil {
+		return x.Dcid
+	}
+	return ""
+}
+
+func (x *PlaceInArea) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *PlaceInArea) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *PlaceInArea) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type GetPlacesInAreaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Places from the closest for the radius query, or sorted by dcid for the
+	// bounding box query.
+	Places []*PlaceInArea `protobuf:"bytes,1,rep,name=places,proto3" json:"places,omitempty"`
+}
+
+func (x *GetPlacesInAreaResponse) Reset() {
+	*x = GetPlacesInAreaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_place_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlacesInAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlacesInAreaResponse) ProtoMessage() {}
+
+func (x *GetPlacesInAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlacesInAreaResponse.ProtoReflect.Descriptor instead.
+func (*GetPlacesInAreaResponse) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPlacesInAreaResponse) GetPlaces() []*PlaceInArea {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
 // Request to get related locations of a place for given stat var DCIDs.
 type GetRelatedLocationsRequest struct {
 	state         protoimpl.MessageState
@@ -308,12 +612,26 @@ type GetRelatedLocationsRequest struct {
 	WithinPlace string `protobuf:"bytes,4,opt,name=within_place,json=withinPlace,proto3" json:"within_place,omitempty"`
 	// (Optional) Whether the computation needs to be based on per capita.
 	IsPerCapita bool `protobuf:"varint,5,opt,name=is_per_capita,json=isPerCapita,proto3" json:"is_per_capita,omitempty"`
+	// (Optional) Compute the places closest to dcid over the vector of stat vars
+	// from the latest observations, instead of reading the precomputed related
+	// places. Candidates are the places of place_type (defaults to the type of
+	// dcid) within within_place (defaults to "Earth") with data for all the stat
+	// vars.
+	Live      bool   `protobuf:"varint,6,opt,name=live,proto3" json:"live,omitempty"`
+	PlaceType string `protobuf:"bytes,7,opt,name=place_type,json=placeType,proto3" json:"place_type,omitempty"`
+	// (Optional) Distance of live similarity, "euclidean" (default) or "cosine".
+	Distance string `protobuf:"bytes,8,opt,name=distance,proto3" json:"distance,omitempty"`
+	// (Optional) Z-score each stat var over the candidates before computing the
+	// distance.
+	Normalize bool `protobuf:"varint,9,opt,name=normalize,proto3" json:"normalize,omitempty"`
+	// (Optional) Number of similar places of live similarity, defaults to 5.
+	TopN int32 `protobuf:"varint,10,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
 }
 
 func (x *GetRelatedLocationsRequest) Reset() {
 	*x = GetRelatedLocationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[4]
+		mi := &file_place_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -326,7 +644,7 @@ func (x *GetRelatedLocationsRequest) String() string {
 func (*GetRelatedLocationsRequest) ProtoMessage() {}
 
 func (x *GetRelatedLocationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[4]
+	mi := &file_place_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +657,7 @@ func (x *GetRelatedLocationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRelatedLocationsRequest.ProtoReflect.Descriptor instead.
 func (*GetRelatedLocationsRequest) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{4}
+	return file_place_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetRelatedLocationsRequest) GetDcid() string {
@@ -370,6 +688,106 @@ func (x *GetRelatedLocationsRequest) GetIsPerCapita() bool {
 	return false
 }
 
+func (x *GetRelatedLocationsRequest) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *GetRelatedLocationsRequest) GetPlaceType() string {
+	if x != nil {
+		return x.PlaceType
+	}
+	return ""
+}
+
+func (x *GetRelatedLocationsRequest) GetDistance() string {
+	if x != nil {
+		return x.Distance
+	}
+	return ""
+}
+
+func (x *GetRelatedLocationsRequest) GetNormalize() bool {
+	if x != nil {
+		return x.Normalize
+	}
+	return false
+}
+
+func (x *GetRelatedLocationsRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+// A place similar to the requested place.
+type SimilarPlace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaceDcid string  `protobuf:"bytes,1,opt,name=place_dcid,json=placeDcid,proto3" json:"place_dcid,omitempty"`
+	Distance  float64 `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Date of the value used for each stat var, keyed by stat var dcid.
+	Dates map[string]string `protobuf:"bytes,3,rep,name=dates,proto3" json:"dates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SimilarPlace) Reset() {
+	*x = SimilarPlace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_place_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarPlace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarPlace) ProtoMessage() {}
+
+func (x *SimilarPlace) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarPlace.ProtoReflect.Descriptor instead.
+func (*SimilarPlace) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SimilarPlace) GetPlaceDcid() string {
+	if x != nil {
+		return x.PlaceDcid
+	}
+	return ""
+}
+
+func (x *SimilarPlace) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *SimilarPlace) GetDates() map[string]string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
 // Response of GetRelatedLocations request.
 type GetRelatedLocationsResponse struct {
 	state         protoimpl.MessageState
@@ -378,12 +796,17 @@ type GetRelatedLocationsResponse struct {
 
 	// The JSON payload.
 	Payload string `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Places from the closest, set for live similarity.
+	SimilarPlaces []*SimilarPlace `protobuf:"bytes,2,rep,name=similar_places,json=similarPlaces,proto3" json:"similar_places,omitempty"`
+	// Date of the value used for each stat var of the requested place, set for
+	// live similarity.
+	Dates map[string]string `protobuf:"bytes,3,rep,name=dates,proto3" json:"dates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *GetRelatedLocationsResponse) Reset() {
 	*x = GetRelatedLocationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[5]
+		mi := &file_place_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -396,7 +819,7 @@ func (x *GetRelatedLocationsResponse) String() string {
 func (*GetRelatedLocationsResponse) ProtoMessage() {}
 
 func (x *GetRelatedLocationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[5]
+	mi := &file_place_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +832,7 @@ func (x *GetRelatedLocationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRelatedLocationsResponse.ProtoReflect.Descriptor instead.
 func (*GetRelatedLocationsResponse) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{5}
+	return file_place_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetRelatedLocationsResponse) GetPayload() string {
@@ -419,6 +842,20 @@ func (x *GetRelatedLocationsResponse) GetPayload() string {
 	return ""
 }
 
+func (x *GetRelatedLocationsResponse) GetSimilarPlaces() []*SimilarPlace {
+	if x != nil {
+		return x.SimilarPlaces
+	}
+	return nil
+}
+
+func (x *GetRelatedLocationsResponse) GetDates() map[string]string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
 // Request to get rankings of locations for given stat var DCIDs.
 type GetLocationsRankingsRequest struct {
 	state         protoimpl.MessageState
@@ -432,12 +869,22 @@ type GetLocationsRankingsRequest struct {
 	WithinPlace string `protobuf:"bytes,3,opt,name=within_place,json=withinPlace,proto3" json:"within_place,omitempty"`
 	// (Optional) Whether the computation needs to be based on per capita.
 	IsPerCapita bool `protobuf:"varint,4,opt,name=is_per_capita,json=isPerCapita,proto3" json:"is_per_capita,omitempty"`
+	// (Optional) Compute the rankings from the observations of the places of
+	// place_type within within_place (defaults to "Earth"), instead of reading the
+	// precomputed rankings. Any stat var with data can be ranked.
+	Live bool `protobuf:"varint,5,opt,name=live,proto3" json:"live,omitempty"`
+	// (Optional) Date of the observations of live rankings. The latest
+	// observation of each place is used if unspecified.
+	Date string `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	// (Optional) Number of places in rank_top_1000 and rank_bottom_1000 of live
+	// rankings, defaults to 1000. Places tied with the last one are included.
+	TopN int32 `protobuf:"varint,7,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
 }
 
 func (x *GetLocationsRankingsRequest) Reset() {
 	*x = GetLocationsRankingsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[6]
+		mi := &file_place_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +897,7 @@ func (x *GetLocationsRankingsRequest) String() string {
 func (*GetLocationsRankingsRequest) ProtoMessage() {}
 
 func (x *GetLocationsRankingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[6]
+	mi := &file_place_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +910,7 @@ func (x *GetLocationsRankingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLocationsRankingsRequest.ProtoReflect.Descriptor instead.
 func (*GetLocationsRankingsRequest) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{6}
+	return file_place_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetLocationsRankingsRequest) GetStatVarDcids() []string {
@@ -494,6 +941,27 @@ func (x *GetLocationsRankingsRequest) GetIsPerCapita() bool {
 	return false
 }
 
+func (x *GetLocationsRankingsRequest) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *GetLocationsRankingsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetLocationsRankingsRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
 type GetLocationsRankingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -505,7 +973,7 @@ type GetLocationsRankingsResponse struct {
 func (x *GetLocationsRankingsResponse) Reset() {
 	*x = GetLocationsRankingsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[7]
+		mi := &file_place_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -518,7 +986,7 @@ func (x *GetLocationsRankingsResponse) String() string {
 func (*GetLocationsRankingsResponse) ProtoMessage() {}
 
 func (x *GetLocationsRankingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[7]
+	mi := &file_place_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +999,7 @@ func (x *GetLocationsRankingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLocationsRankingsResponse.ProtoReflect.Descriptor instead.
 func (*GetLocationsRankingsResponse) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{7}
+	return file_place_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetLocationsRankingsResponse) GetPayload() map[string]*RelatedPlacesInfo {
@@ -553,7 +1021,7 @@ type StatsVars struct {
 func (x *StatsVars) Reset() {
 	*x = StatsVars{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[8]
+		mi := &file_place_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +1034,7 @@ func (x *StatsVars) String() string {
 func (*StatsVars) ProtoMessage() {}
 
 func (x *StatsVars) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[8]
+	mi := &file_place_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +1047,7 @@ func (x *StatsVars) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsVars.ProtoReflect.Descriptor instead.
 func (*StatsVars) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{8}
+	return file_place_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *StatsVars) GetStatsVars() []string {
@@ -602,7 +1070,7 @@ type GetPlaceStatsVarRequest struct {
 func (x *GetPlaceStatsVarRequest) Reset() {
 	*x = GetPlaceStatsVarRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[9]
+		mi := &file_place_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -615,7 +1083,7 @@ func (x *GetPlaceStatsVarRequest) String() string {
 func (*GetPlaceStatsVarRequest) ProtoMessage() {}
 
 func (x *GetPlaceStatsVarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[9]
+	mi := &file_place_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +1096,7 @@ func (x *GetPlaceStatsVarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatsVarRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatsVarRequest) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{9}
+	return file_place_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetPlaceStatsVarRequest) GetDcids() []string {
@@ -651,7 +1119,7 @@ type GetPlaceStatsVarResponse struct {
 func (x *GetPlaceStatsVarResponse) Reset() {
 	*x = GetPlaceStatsVarResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[10]
+		mi := &file_place_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -664,7 +1132,7 @@ func (x *GetPlaceStatsVarResponse) String() string {
 func (*GetPlaceStatsVarResponse) ProtoMessage() {}
 
 func (x *GetPlaceStatsVarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[10]
+	mi := &file_place_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,7 +1145,7 @@ func (x *GetPlaceStatsVarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatsVarResponse.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatsVarResponse) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{10}
+	return file_place_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetPlaceStatsVarResponse) GetPlaces() map[string]*StatsVars {
@@ -699,7 +1167,7 @@ type StatVars struct {
 func (x *StatVars) Reset() {
 	*x = StatVars{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[11]
+		mi := &file_place_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -712,7 +1180,7 @@ func (x *StatVars) String() string {
 func (*StatVars) ProtoMessage() {}
 
 func (x *StatVars) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[11]
+	mi := &file_place_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +1193,7 @@ func (x *StatVars) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatVars.ProtoReflect.Descriptor instead.
 func (*StatVars) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{11}
+	return file_place_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *StatVars) GetStatVars() []string {
@@ -748,7 +1216,7 @@ type GetPlaceStatVarsRequest struct {
 func (x *GetPlaceStatVarsRequest) Reset() {
 	*x = GetPlaceStatVarsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[12]
+		mi := &file_place_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -761,7 +1229,7 @@ func (x *GetPlaceStatVarsRequest) String() string {
 func (*GetPlaceStatVarsRequest) ProtoMessage() {}
 
 func (x *GetPlaceStatVarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[12]
+	mi := &file_place_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,7 +1242,7 @@ func (x *GetPlaceStatVarsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatVarsRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatVarsRequest) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{12}
+	return file_place_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetPlaceStatVarsRequest) GetDcids() []string {
@@ -797,7 +1265,7 @@ type GetPlaceStatVarsResponse struct {
 func (x *GetPlaceStatVarsResponse) Reset() {
 	*x = GetPlaceStatVarsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[13]
+		mi := &file_place_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -810,7 +1278,7 @@ func (x *GetPlaceStatVarsResponse) String() string {
 func (*GetPlaceStatVarsResponse) ProtoMessage() {}
 
 func (x *GetPlaceStatVarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[13]
+	mi := &file_place_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1291,7 @@ func (x *GetPlaceStatVarsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatVarsResponse.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatVarsResponse) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{13}
+	return file_place_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetPlaceStatVarsResponse) GetPlaces() map[string]*StatVars {
@@ -848,7 +1316,7 @@ type GetPlaceStatVarsUnionRequest struct {
 func (x *GetPlaceStatVarsUnionRequest) Reset() {
 	*x = GetPlaceStatVarsUnionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[14]
+		mi := &file_place_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -861,7 +1329,7 @@ func (x *GetPlaceStatVarsUnionRequest) String() string {
 func (*GetPlaceStatVarsUnionRequest) ProtoMessage() {}
 
 func (x *GetPlaceStatVarsUnionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[14]
+	mi := &file_place_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +1342,7 @@ func (x *GetPlaceStatVarsUnionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatVarsUnionRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatVarsUnionRequest) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{14}
+	return file_place_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetPlaceStatVarsUnionRequest) GetDcids() []string {
@@ -903,7 +1371,7 @@ type GetPlaceStatVarsUnionResponse struct {
 func (x *GetPlaceStatVarsUnionResponse) Reset() {
 	*x = GetPlaceStatVarsUnionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[15]
+		mi := &file_place_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -916,7 +1384,7 @@ func (x *GetPlaceStatVarsUnionResponse) String() string {
 func (*GetPlaceStatVarsUnionResponse) ProtoMessage() {}
 
 func (x *GetPlaceStatVarsUnionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[15]
+	mi := &file_place_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +1397,7 @@ func (x *GetPlaceStatVarsUnionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatVarsUnionResponse.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatVarsUnionResponse) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{15}
+	return file_place_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetPlaceStatVarsUnionResponse) GetStatVars() []string {
@@ -947,12 +1415,16 @@ type GetPlaceStatDateWithinPlaceRequest struct {
 	AncestorPlace string   `protobuf:"bytes,1,opt,name=ancestor_place,json=ancestorPlace,proto3" json:"ancestor_place,omitempty"`
 	PlaceType     string   `protobuf:"bytes,2,opt,name=place_type,json=placeType,proto3" json:"place_type,omitempty"`
 	StatVars      []string `protobuf:"bytes,3,rep,name=stat_vars,json=statVars,proto3" json:"stat_vars,omitempty"`
+	// (Optional) Place set to use instead of ancestor_place and place_type. Only
+	// parent_places and child_types are supported, and the dates of all the
+	// selected collections are returned.
+	PlaceSelector *PlaceSelector `protobuf:"bytes,4,opt,name=place_selector,json=placeSelector,proto3" json:"place_selector,omitempty"`
 }
 
 func (x *GetPlaceStatDateWithinPlaceRequest) Reset() {
 	*x = GetPlaceStatDateWithinPlaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[16]
+		mi := &file_place_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -965,7 +1437,7 @@ func (x *GetPlaceStatDateWithinPlaceRequest) String() string {
 func (*GetPlaceStatDateWithinPlaceRequest) ProtoMessage() {}
 
 func (x *GetPlaceStatDateWithinPlaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[16]
+	mi := &file_place_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1450,7 @@ func (x *GetPlaceStatDateWithinPlaceRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetPlaceStatDateWithinPlaceRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatDateWithinPlaceRequest) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{16}
+	return file_place_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetPlaceStatDateWithinPlaceRequest) GetAncestorPlace() string {
@@ -1002,6 +1474,13 @@ func (x *GetPlaceStatDateWithinPlaceRequest) GetStatVars() []string {
 	return nil
 }
 
+func (x *GetPlaceStatDateWithinPlaceRequest) GetPlaceSelector() *PlaceSelector {
+	if x != nil {
+		return x.PlaceSelector
+	}
+	return nil
+}
+
 type GetPlaceStatDateWithinPlaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1014,7 +1493,7 @@ type GetPlaceStatDateWithinPlaceResponse struct {
 func (x *GetPlaceStatDateWithinPlaceResponse) Reset() {
 	*x = GetPlaceStatDateWithinPlaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[17]
+		mi := &file_place_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1027,7 +1506,7 @@ func (x *GetPlaceStatDateWithinPlaceResponse) String() string {
 func (*GetPlaceStatDateWithinPlaceResponse) ProtoMessage() {}
 
 func (x *GetPlaceStatDateWithinPlaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[17]
+	mi := &file_place_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,7 +1519,7 @@ func (x *GetPlaceStatDateWithinPlaceResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetPlaceStatDateWithinPlaceResponse.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatDateWithinPlaceResponse) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{17}
+	return file_place_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetPlaceStatDateWithinPlaceResponse) GetData() map[string]*DateList {
@@ -1064,7 +1543,7 @@ type PlaceMetadataCache struct {
 func (x *PlaceMetadataCache) Reset() {
 	*x = PlaceMetadataCache{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[18]
+		mi := &file_place_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1077,7 +1556,7 @@ func (x *PlaceMetadataCache) String() string {
 func (*PlaceMetadataCache) ProtoMessage() {}
 
 func (x *PlaceMetadataCache) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[18]
+	mi := &file_place_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,7 +1569,7 @@ func (x *PlaceMetadataCache) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceMetadataCache.ProtoReflect.Descriptor instead.
 func (*PlaceMetadataCache) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{18}
+	return file_place_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PlaceMetadataCache) GetPlaces() []*PlaceMetadataCache_PlaceInfo {
@@ -1113,7 +1592,7 @@ type PlaceMetadata struct {
 func (x *PlaceMetadata) Reset() {
 	*x = PlaceMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[19]
+		mi := &file_place_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1126,7 +1605,7 @@ func (x *PlaceMetadata) String() string {
 func (*PlaceMetadata) ProtoMessage() {}
 
 func (x *PlaceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[19]
+	mi := &file_place_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,7 +1618,7 @@ func (x *PlaceMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceMetadata.ProtoReflect.Descriptor instead.
 func (*PlaceMetadata) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{19}
+	return file_place_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PlaceMetadata) GetSelf() *PlaceMetadata_PlaceInfo {
@@ -1167,7 +1646,7 @@ type GetPlaceMetadataRequest struct {
 func (x *GetPlaceMetadataRequest) Reset() {
 	*x = GetPlaceMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[20]
+		mi := &file_place_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1180,7 +1659,7 @@ func (x *GetPlaceMetadataRequest) String() string {
 func (*GetPlaceMetadataRequest) ProtoMessage() {}
 
 func (x *GetPlaceMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[20]
+	mi := &file_place_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,42 +1672,137 @@ func (x *GetPlaceMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceMetadataRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{20}
+	return file_place_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPlaceMetadataRequest) GetPlaces() []string {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+type GetPlaceMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keyed by place dcid.
+	Data map[string]*PlaceMetadata `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetPlaceMetadataResponse) Reset() {
+	*x = GetPlaceMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_place_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaceMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaceMetadataResponse) ProtoMessage() {}
+
+func (x *GetPlaceMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaceMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaceMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPlaceMetadataResponse) GetData() map[string]*PlaceMetadata {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ResolveCoordinatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coordinates []*ResolveCoordinatesRequest_Coordinate `protobuf:"bytes,1,rep,name=coordinates,proto3" json:"coordinates,omitempty"`
+}
+
+func (x *ResolveCoordinatesRequest) Reset() {
+	*x = ResolveCoordinatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_place_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveCoordinatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCoordinatesRequest) ProtoMessage() {}
+
+func (x *ResolveCoordinatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCoordinatesRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCoordinatesRequest) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetPlaceMetadataRequest) GetPlaces() []string {
+func (x *ResolveCoordinatesRequest) GetCoordinates() []*ResolveCoordinatesRequest_Coordinate {
 	if x != nil {
-		return x.Places
+		return x.Coordinates
 	}
 	return nil
 }
 
-type GetPlaceMetadataResponse struct {
+type ResolveCoordinatesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Keyed by place dcid.
-	Data map[string]*PlaceMetadata `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// In the order of the request coordinates.
+	PlaceCoordinates []*ResolveCoordinatesResponse_PlaceCoordinate `protobuf:"bytes,1,rep,name=place_coordinates,json=placeCoordinates,proto3" json:"place_coordinates,omitempty"`
 }
 
-func (x *GetPlaceMetadataResponse) Reset() {
-	*x = GetPlaceMetadataResponse{}
+func (x *ResolveCoordinatesResponse) Reset() {
+	*x = ResolveCoordinatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[21]
+		mi := &file_place_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPlaceMetadataResponse) String() string {
+func (x *ResolveCoordinatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlaceMetadataResponse) ProtoMessage() {}
+func (*ResolveCoordinatesResponse) ProtoMessage() {}
 
-func (x *GetPlaceMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[21]
+func (x *ResolveCoordinatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,14 +1813,14 @@ func (x *GetPlaceMetadataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlaceMetadataResponse.ProtoReflect.Descriptor instead.
-func (*GetPlaceMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ResolveCoordinatesResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCoordinatesResponse) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetPlaceMetadataResponse) GetData() map[string]*PlaceMetadata {
+func (x *ResolveCoordinatesResponse) GetPlaceCoordinates() []*ResolveCoordinatesResponse_PlaceCoordinate {
 	if x != nil {
-		return x.Data
+		return x.PlaceCoordinates
 	}
 	return nil
 }
@@ -1262,7 +1836,7 @@ type RelatedPlacesInfo_Ranking struct {
 func (x *RelatedPlacesInfo_Ranking) Reset() {
 	*x = RelatedPlacesInfo_Ranking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[22]
+		mi := &file_place_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1275,7 +1849,7 @@ func (x *RelatedPlacesInfo_Ranking) String() string {
 func (*RelatedPlacesInfo_Ranking) ProtoMessage() {}
 
 func (x *RelatedPlacesInfo_Ranking) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[22]
+	mi := &file_place_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1306,12 +1880,15 @@ type RelatedPlacesInfo_Ranking_RankInfo struct {
 	Rank      int32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
 	Value     float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
 	PlaceDcid string  `protobuf:"bytes,3,opt,name=place_dcid,json=placeDcid,proto3" json:"place_dcid,omitempty"`
+	// Percentile rank of the value among all the places, set by live
+	// rankings.
+	Percentile float64 `protobuf:"fixed64,4,opt,name=percentile,proto3" json:"percentile,omitempty"`
 }
 
 func (x *RelatedPlacesInfo_Ranking_RankInfo) Reset() {
 	*x = RelatedPlacesInfo_Ranking_RankInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[23]
+		mi := &file_place_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1324,7 +1901,7 @@ func (x *RelatedPlacesInfo_Ranking_RankInfo) String() string {
 func (*RelatedPlacesInfo_Ranking_RankInfo) ProtoMessage() {}
 
 func (x *RelatedPlacesInfo_Ranking_RankInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[23]
+	mi := &file_place_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1361,6 +1938,13 @@ func (x *RelatedPlacesInfo_Ranking_RankInfo) GetPlaceDcid() string {
 	return ""
 }
 
+func (x *RelatedPlacesInfo_Ranking_RankInfo) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
 type PlaceMetadataCache_PlaceInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1378,7 +1962,7 @@ type PlaceMetadataCache_PlaceInfo struct {
 func (x *PlaceMetadataCache_PlaceInfo) Reset() {
 	*x = PlaceMetadataCache_PlaceInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[28]
+		mi := &file_place_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1391,7 +1975,7 @@ func (x *PlaceMetadataCache_PlaceInfo) String() string {
 func (*PlaceMetadataCache_PlaceInfo) ProtoMessage() {}
 
 func (x *PlaceMetadataCache_PlaceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[28]
+	mi := &file_place_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,7 +1988,7 @@ func (x *PlaceMetadataCache_PlaceInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceMetadataCache_PlaceInfo.ProtoReflect.Descriptor instead.
 func (*PlaceMetadataCache_PlaceInfo) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{18, 0}
+	return file_place_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *PlaceMetadataCache_PlaceInfo) GetDcid() string {
@@ -1449,7 +2033,7 @@ type PlaceMetadata_PlaceInfo struct {
 func (x *PlaceMetadata_PlaceInfo) Reset() {
 	*x = PlaceMetadata_PlaceInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_place_proto_msgTypes[29]
+		mi := &file_place_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1462,7 +2046,7 @@ func (x *PlaceMetadata_PlaceInfo) String() string {
 func (*PlaceMetadata_PlaceInfo) ProtoMessage() {}
 
 func (x *PlaceMetadata_PlaceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_place_proto_msgTypes[29]
+	mi := &file_place_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,7 +2059,7 @@ func (x *PlaceMetadata_PlaceInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceMetadata_PlaceInfo.ProtoReflect.Descriptor instead.
 func (*PlaceMetadata_PlaceInfo) Descriptor() ([]byte, []int) {
-	return file_place_proto_rawDescGZIP(), []int{19, 0}
+	return file_place_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *PlaceMetadata_PlaceInfo) GetDcid() string {
@@ -1499,198 +2083,429 @@ func (x *PlaceMetadata_PlaceInfo) GetType() string {
 	return ""
 }
 
+type ResolveCoordinatesRequest_Coordinate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *ResolveCoordinatesRequest_Coordinate) Reset() {
+	*x = ResolveCoordinatesRequest_Coordinate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_place_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveCoordinatesRequest_Coordinate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCoordinatesRequest_Coordinate) ProtoMessage() {}
+
+func (x *ResolveCoordinatesRequest_Coordinate) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCoordinatesRequest_Coordinate.ProtoReflect.Descriptor instead.
+func (*ResolveCoordinatesRequest_Coordinate) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *ResolveCoordinatesRequest_Coordinate) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ResolveCoordinatesRequest_Coordinate) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type ResolveCoordinatesResponse_PlaceCoordinate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// The smallest place whose boundary contains the coordinate and its
+	// ancestors. Unset when no boundary contains the coordinate.
+	Metadata *PlaceMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *ResolveCoordinatesResponse_PlaceCoordinate) Reset() {
+	*x = ResolveCoordinatesResponse_PlaceCoordinate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_place_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveCoordinatesResponse_PlaceCoordinate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCoordinatesResponse_PlaceCoordinate) ProtoMessage() {}
+
+func (x *ResolveCoordinatesResponse_PlaceCoordinate) ProtoReflect() protoreflect.Message {
+	mi := &file_place_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCoordinatesResponse_PlaceCoordinate.ProtoReflect.Descriptor instead.
+func (*ResolveCoordinatesResponse_PlaceCoordinate) Descriptor() ([]byte, []int) {
+	return file_place_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *ResolveCoordinatesResponse_PlaceCoordinate) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ResolveCoordinatesResponse_PlaceCoordinate) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *ResolveCoordinatesResponse_PlaceCoordinate) GetMetadata() *PlaceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 var File_place_proto protoreflect.FileDescriptor
 
 var file_place_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x22, 0x20, 0x0a, 0x08, 0x44, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xa1, 0x04, 0x0a,
-	0x11, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x61, 0x6e,
-	0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x70, 0x12, 0x28, 0x0a,
-	0x10, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6f, 0x74, 0x74, 0x6f,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x46, 0x72, 0x6f,
-	0x6d, 0x42, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x5f,
-	0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x41, 0x6c, 0x6c, 0x12, 0x4a, 0x0a, 0x0d, 0x72, 0x61,
-	0x6e, 0x6b, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x31, 0x30, 0x30, 0x30, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x54,
-	0x6f, 0x70, 0x31, 0x30, 0x30, 0x30, 0x12, 0x50, 0x0a, 0x10, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x62,
-	0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x5f, 0x31, 0x30, 0x30, 0x30, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x20, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xc1, 0x04, 0x0a, 0x11, 0x52,
 	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f,
-	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x42, 0x6f,
-	0x74, 0x74, 0x6f, 0x6d, 0x31, 0x30, 0x30, 0x30, 0x1a, 0xa3, 0x01, 0x0a, 0x07, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x53, 0x0a, 0x08, 0x52, 0x61, 0x6e,
-	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x64, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x63, 0x69, 0x64, 0x4a, 0x04,
-	0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07,
-	0x22, 0x49, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x61, 0x6e, 0x6b, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x72, 0x61, 0x6e, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x61, 0x6e, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x42,
+	0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x6c,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x72, 0x61, 0x6e, 0x6b, 0x41, 0x6c, 0x6c, 0x12, 0x4a, 0x0a, 0x0d, 0x72, 0x61, 0x6e, 0x6b,
+	0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x31, 0x30, 0x30, 0x30, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x54, 0x6f, 0x70,
+	0x31, 0x30, 0x30, 0x30, 0x12, 0x50, 0x0a, 0x10, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x62, 0x6f, 0x74,
+	0x74, 0x6f, 0x6d, 0x5f, 0x31, 0x30, 0x30, 0x30, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x42, 0x6f, 0x74, 0x74,
+	0x6f, 0x6d, 0x31, 0x30, 0x30, 0x30, 0x1a, 0xc3, 0x01, 0x0a, 0x07, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x43, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x73, 0x0a, 0x08, 0x52, 0x61, 0x6e, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x64, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x63, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x66,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0x2f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d,
+	0x69, 0x6e, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6b, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x62, 0x6f, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78,
+	0x52, 0x04, 0x62, 0x62, 0x6f, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76,
+	0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x73, 0x22, 0x7c, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b,
+	0x6d, 0x22, 0x4b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e,
+	0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x49, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22, 0x9f,
+	0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x64, 0x63,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x44, 0x63, 0x69, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x69,
+	0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x70, 0x69, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69,
+	0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x5f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4e,
+	0x22, 0xbf, 0x01, 0x0a, 0x0c, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x64, 0x63, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x63, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x05,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xfe, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x9d, 0x01, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12,
-	0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x64, 0x63, 0x69, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
-	0x44, 0x63, 0x69, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x74,
-	0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x70,
-	0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x69, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x70, 0x69, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x1b,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61,
-	0x72, 0x5f, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73,
-	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x63, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69,
-	0x74, 0x68, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a,
-	0x0d, 0x69, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x70, 0x69, 0x74,
-	0x61, 0x22, 0xcc, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61,
-	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x5a, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x29, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x40, 0x0a, 0x0e,
+	0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
+	0x0d, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x49,
+	0x0a, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x44, 0x61, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xe6, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f,
+	0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x56, 0x61, 0x72, 0x44, 0x63, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x70, 0x69, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
+	0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4e, 0x22, 0xcc, 0x01, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a,
+	0x5a, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x09, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x56, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x1a,
+	0x51, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x27, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x22, 0xb8, 0x01, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x22, 0xb7, 0x01, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x6c, 0x61,
 	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x73, 0x1a, 0x51, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e,
+	0x61, 0x63, 0x65, 0x73, 0x1a, 0x50, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x56,
-	0x61, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73,
-	0x22, 0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64,
-	0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64,
-	0x73, 0x22, 0xb7, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
-	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x50, 0x0a, 0x0b, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x51, 0x0a, 0x1c, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55,
-	0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64,
-	0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0x3c,
-	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61,
-	0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0x87, 0x01, 0x0a,
-	0x22, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x74, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61,
-	0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
-	0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69,
-	0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x64,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x51, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x1d, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
+	0x73, 0x12, 0x41, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x4e, 0x0a, 0x09,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xba, 0x01, 0x0a,
+	0x12, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x61, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x0d, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x04, 0x73,
+	0x65, 0x6c, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x73, 0x65, 0x6c, 0x66, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x47, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x31,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x73, 0x22, 0xb4, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64,
 	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69,
-	0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x4e,
-	0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xba,
-	0x01, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x61, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x0d,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a,
-	0x04, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x1a, 0x53, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x0b,
+	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x46, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x10, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x0f, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x47, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x43, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x53, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09,
+	0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1705,63 +2520,84 @@ func file_place_proto_rawDescGZIP() []byte {
 	return file_place_proto_rawDescData
 }
 
-var file_place_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_place_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_place_proto_goTypes = []interface{}{
 	(*DateList)(nil),                            // 0: datacommons.DateList
 	(*RelatedPlacesInfo)(nil),                   // 1: datacommons.RelatedPlacesInfo
 	(*GetPlacesInRequest)(nil),                  // 2: datacommons.GetPlacesInRequest
 	(*GetPlacesInResponse)(nil),                 // 3: datacommons.GetPlacesInResponse
-	(*GetRelatedLocationsRequest)(nil),          // 4: datacommons.GetRelatedLocationsRequest
-	(*GetRelatedLocationsResponse)(nil),         // 5: datacommons.GetRelatedLocationsResponse
-	(*GetLocationsRankingsRequest)(nil),         // 6: datacommons.GetLocationsRankingsRequest
-	(*GetLocationsRankingsResponse)(nil),        // 7: datacommons.GetLocationsRankingsResponse
-	(*StatsVars)(nil),                           // 8: datacommons.StatsVars
-	(*GetPlaceStatsVarRequest)(nil),             // 9: datacommons.GetPlaceStatsVarRequest
-	(*GetPlaceStatsVarResponse)(nil),            // 10: datacommons.GetPlaceStatsVarResponse
-	(*StatVars)(nil),                            // 11: datacommons.StatVars
-	(*GetPlaceStatVarsRequest)(nil),             // 12: datacommons.GetPlaceStatVarsRequest
-	(*GetPlaceStatVarsResponse)(nil),            // 13: datacommons.GetPlaceStatVarsResponse
-	(*GetPlaceStatVarsUnionRequest)(nil),        // 14: datacommons.GetPlaceStatVarsUnionRequest
-	(*GetPlaceStatVarsUnionResponse)(nil),       // 15: datacommons.GetPlaceStatVarsUnionResponse
-	(*GetPlaceStatDateWithinPlaceRequest)(nil),  // 16: datacommons.GetPlaceStatDateWithinPlaceRequest
-	(*GetPlaceStatDateWithinPlaceResponse)(nil), // 17: datacommons.GetPlaceStatDateWithinPlaceResponse
-	(*PlaceMetadataCache)(nil),                  // 18: datacommons.PlaceMetadataCache
-	(*PlaceMetadata)(nil),                       // 19: datacommons.PlaceMetadata
-	(*GetPlaceMetadataRequest)(nil),             // 20: datacommons.GetPlaceMetadataRequest
-	(*GetPlaceMetadataResponse)(nil),            // 21: datacommons.GetPlaceMetadataResponse
-	(*RelatedPlacesInfo_Ranking)(nil),           // 22: datacommons.RelatedPlacesInfo.Ranking
-	(*RelatedPlacesInfo_Ranking_RankInfo)(nil),  // 23: datacommons.RelatedPlacesInfo.Ranking.RankInfo
-	nil,                                  // 24: datacommons.GetLocationsRankingsResponse.PayloadEntry
-	nil,                                  // 25: datacommons.GetPlaceStatsVarResponse.PlacesEntry
-	nil,                                  // 26: datacommons.GetPlaceStatVarsResponse.PlacesEntry
-	nil,                                  // 27: datacommons.GetPlaceStatDateWithinPlaceResponse.DataEntry
-	(*PlaceMetadataCache_PlaceInfo)(nil), // 28: datacommons.PlaceMetadataCache.PlaceInfo
-	(*PlaceMetadata_PlaceInfo)(nil),      // 29: datacommons.PlaceMetadata.PlaceInfo
-	nil,                                  // 30: datacommons.GetPlaceMetadataResponse.DataEntry
+	(*BoundingBox)(nil),                         // 4: datacommons.BoundingBox
+	(*GetPlacesInAreaRequest)(nil),              // 5: datacommons.GetPlacesInAreaRequest
+	(*PlaceInArea)(nil),                         // 6: datacommons.PlaceInArea
+	(*GetPlacesInAreaResponse)(nil),             // 7: datacommons.GetPlacesInAreaResponse
+	(*GetRelatedLocationsRequest)(nil),          // 8: datacommons.GetRelatedLocationsRequest
+	(*SimilarPlace)(nil),                        // 9: datacommons.SimilarPlace
+	(*GetRelatedLocationsResponse)(nil),         // 10: datacommons.GetRelatedLocationsResponse
+	(*GetLocationsRankingsRequest)(nil),         // 11: datacommons.GetLocationsRankingsRequest
+	(*GetLocationsRankingsResponse)(nil),        // 12: datacommons.GetLocationsRankingsResponse
+	(*StatsVars)(nil),                           // 13: datacommons.StatsVars
+	(*GetPlaceStatsVarRequest)(nil),             // 14: datacommons.GetPlaceStatsVarRequest
+	(*GetPlaceStatsVarResponse)(nil),            // 15: datacommons.GetPlaceStatsVarResponse
+	(*StatVars)(nil),                            // 16: datacommons.StatVars
+	(*GetPlaceStatVarsRequest)(nil),             // 17: datacommons.GetPlaceStatVarsRequest
+	(*GetPlaceStatVarsResponse)(nil),            // 18: datacommons.GetPlaceStatVarsResponse
+	(*GetPlaceStatVarsUnionRequest)(nil),        // 19: datacommons.GetPlaceStatVarsUnionRequest
+	(*GetPlaceStatVarsUnionResponse)(nil),       // 20: datacommons.GetPlaceStatVarsUnionResponse
+	(*GetPlaceStatDateWithinPlaceRequest)(nil),  // 21: datacommons.GetPlaceStatDateWithinPlaceRequest
+	(*GetPlaceStatDateWithinPlaceResponse)(nil), // 22: datacommons.GetPlaceStatDateWithinPlaceResponse
+	(*PlaceMetadataCache)(nil),                  // 23: datacommons.PlaceMetadataCache
+	(*PlaceMetadata)(nil),                       // 24: datacommons.PlaceMetadata
+	(*GetPlaceMetadataRequest)(nil),             // 25: datacommons.GetPlaceMetadataRequest
+	(*GetPlaceMetadataResponse)(nil),            // 26: datacommons.GetPlaceMetadataResponse
+	(*ResolveCoordinatesRequest)(nil),           // 27: datacommons.ResolveCoordinatesRequest
+	(*ResolveCoordinatesResponse)(nil),          // 28: datacommons.ResolveCoordinatesResponse
+	(*RelatedPlacesInfo_Ranking)(nil),           // 29: datacommons.RelatedPlacesInfo.Ranking
+	(*RelatedPlacesInfo_Ranking_RankInfo)(nil),  // 30: datacommons.RelatedPlacesInfo.Ranking.RankInfo
+	nil,                                  // 31: datacommons.SimilarPlace.DatesEntry
+	nil,                                  // 32: datacommons.GetRelatedLocationsResponse.DatesEntry
+	nil,                                  // 33: datacommons.GetLocationsRankingsResponse.PayloadEntry
+	nil,                                  // 34: datacommons.GetPlaceStatsVarResponse.PlacesEntry
+	nil,                                  // 35: datacommons.GetPlaceStatVarsResponse.PlacesEntry
+	nil,                                  // 36: datacommons.GetPlaceStatDateWithinPlaceResponse.DataEntry
+	(*PlaceMetadataCache_PlaceInfo)(nil), // 37: datacommons.PlaceMetadataCache.PlaceInfo
+	(*PlaceMetadata_PlaceInfo)(nil),      // 38: datacommons.PlaceMetadata.PlaceInfo
+	nil,                                  // 39: datacommons.GetPlaceMetadataResponse.DataEntry
+	(*ResolveCoordinatesRequest_Coordinate)(nil),       // 40: datacommons.ResolveCoordinatesRequest.Coordinate
+	(*ResolveCoordinatesResponse_PlaceCoordinate)(nil), // 41: datacommons.ResolveCoordinatesResponse.PlaceCoordinate
+	(*PlaceSelector)(nil),                              // 42: datacommons.PlaceSelector
 }
 var file_place_proto_depIdxs = []int32{
-	22, // 0: datacommons.RelatedPlacesInfo.rank_all:type_name -> datacommons.RelatedPlacesInfo.Ranking
-	22, // 1: datacommons.RelatedPlacesInfo.rank_top_1000:type_name -> datacommons.RelatedPlacesInfo.Ranking
-	22, // 2: datacommons.RelatedPlacesInfo.rank_bottom_1000:type_name -> datacommons.RelatedPlacesInfo.Ranking
-	24, // 3: datacommons.GetLocationsRankingsResponse.payload:type_name -> datacommons.GetLocationsRankingsResponse.PayloadEntry
-	25, // 4: datacommons.GetPlaceStatsVarResponse.places:type_name -> datacommons.GetPlaceStatsVarResponse.PlacesEntry
-	26, // 5: datacommons.GetPlaceStatVarsResponse.places:type_name -> datacommons.GetPlaceStatVarsResponse.PlacesEntry
-	27, // 6: datacommons.GetPlaceStatDateWithinPlaceResponse.data:type_name -> datacommons.GetPlaceStatDateWithinPlaceResponse.DataEntry
-	28, // 7: datacommons.PlaceMetadataCache.places:type_name -> datacommons.PlaceMetadataCache.PlaceInfo
-	29, // 8: datacommons.PlaceMetadata.self:type_name -> datacommons.PlaceMetadata.PlaceInfo
-	29, // 9: datacommons.PlaceMetadata.parents:type_name -> datacommons.PlaceMetadata.PlaceInfo
-	30, // 10: datacommons.GetPlaceMetadataResponse.data:type_name -> datacommons.GetPlaceMetadataResponse.DataEntry
-	23, // 11: datacommons.RelatedPlacesInfo.Ranking.info:type_name -> datacommons.RelatedPlacesInfo.Ranking.RankInfo
-	1,  // 12: datacommons.GetLocationsRankingsResponse.PayloadEntry.value:type_name -> datacommons.RelatedPlacesInfo
-	8,  // 13: datacommons.GetPlaceStatsVarResponse.PlacesEntry.value:type_name -> datacommons.StatsVars
-	11, // 14: datacommons.GetPlaceStatVarsResponse.PlacesEntry.value:type_name -> datacommons.StatVars
-	0,  // 15: datacommons.GetPlaceStatDateWithinPlaceResponse.DataEntry.value:type_name -> datacommons.DateList
-	19, // 16: datacommons.GetPlaceMetadataResponse.DataEntry.value:type_name -> datacommons.PlaceMetadata
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	29, // 0: datacommons.RelatedPlacesInfo.rank_all:type_name -> datacommons.RelatedPlacesInfo.Ranking
+	29, // 1: datacommons.RelatedPlacesInfo.rank_top_1000:type_name -> datacommons.RelatedPlacesInfo.Ranking
+	29, // 2: datacommons.RelatedPlacesInfo.rank_bottom_1000:type_name -> datacommons.RelatedPlacesInfo.Ranking
+	4,  // 3: datacommons.GetPlacesInAreaRequest.bbox:type_name -> datacommons.BoundingBox
+	6,  // 4: datacommons.GetPlacesInAreaResponse.places:type_name -> datacommons.PlaceInArea
+	31, // 5: datacommons.SimilarPlace.dates:type_name -> datacommons.SimilarPlace.DatesEntry
+	9,  // 6: datacommons.GetRelatedLocationsResponse.similar_places:type_name -> datacommons.SimilarPlace
+	32, // 7: datacommons.GetRelatedLocationsResponse.dates:type_name -> datacommons.GetRelatedLocationsResponse.DatesEntry
+	33, // 8: datacommons.GetLocationsRankingsResponse.payload:type_name -> datacommons.GetLocationsRankingsResponse.PayloadEntry
+	34, // 9: datacommons.GetPlaceStatsVarResponse.places:type_name -> datacommons.GetPlaceStatsVarResponse.PlacesEntry
+	35, // 10: datacommons.GetPlaceStatVarsResponse.places:type_name -> datacommons.GetPlaceStatVarsResponse.PlacesEntry
+	42, // 11: datacommons.GetPlaceStatDateWithinPlaceRequest.place_selector:type_name -> datacommons.PlaceSelector
+	36, // 12: datacommons.GetPlaceStatDateWithinPlaceResponse.data:type_name -> datacommons.GetPlaceStatDateWithinPlaceResponse.DataEntry
+	37, // 13: datacommons.PlaceMetadataCache.places:type_name -> datacommons.PlaceMetadataCache.PlaceInfo
+	38, // 14: datacommons.PlaceMetadata.self:type_name -> datacommons.PlaceMetadata.PlaceInfo
+	38, // 15: datacommons.PlaceMetadata.parents:type_name -> datacommons.PlaceMetadata.PlaceInfo
+	39, // 16: datacommons.GetPlaceMetadataResponse.data:type_name -> datacommons.GetPlaceMetadataResponse.DataEntry
+	40, // 17: datacommons.ResolveCoordinatesRequest.coordinates:type_name -> datacommons.ResolveCoordinatesRequest.Coordinate
+	41, // 18: datacommons.ResolveCoordinatesResponse.place_coordinates:type_name -> datacommons.ResolveCoordinatesResponse.PlaceCoordinate
+	30, // 19: datacommons.RelatedPlacesInfo.Ranking.info:type_name -> datacommons.RelatedPlacesInfo.Ranking.RankInfo
+	1,  // 20: datacommons.GetLocationsRankingsResponse.PayloadEntry.value:type_name -> datacommons.RelatedPlacesInfo
+	13, // 21: datacommons.GetPlaceStatsVarResponse.PlacesEntry.value:type_name -> datacommons.StatsVars
+	16, // 22: datacommons.GetPlaceStatVarsResponse.PlacesEntry.value:type_name -> datacommons.StatVars
+	0,  // 23: datacommons.GetPlaceStatDateWithinPlaceResponse.DataEntry.value:type_name -> datacommons.DateList
+	24, // 24: datacommons.GetPlaceMetadataResponse.DataEntry.value:type_name -> datacommons.PlaceMetadata
+	24, // 25: datacommons.ResolveCoordinatesResponse.PlaceCoordinate.metadata:type_name -> datacommons.PlaceMetadata
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_place_proto_init() }
@@ -1769,6 +2605,7 @@ func file_place_proto_init() {
 	if File_place_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_place_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DateList); i {
@@ -1819,7 +2656,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRelatedLocationsRequest); i {
+			switch v := v.(*BoundingBox); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1831,7 +2668,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRelatedLocationsResponse); i {
+			switch v := v.(*GetPlacesInAreaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1843,7 +2680,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLocationsRankingsRequest); i {
+			switch v := v.(*PlaceInArea); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1855,7 +2692,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLocationsRankingsResponse); i {
+			switch v := v.(*GetPlacesInAreaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1867,7 +2704,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsVars); i {
+			switch v := v.(*GetRelatedLocationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1879,7 +2716,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatsVarRequest); i {
+			switch v := v.(*SimilarPlace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1891,7 +2728,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatsVarResponse); i {
+			switch v := v.(*GetRelatedLocationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1903,7 +2740,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatVars); i {
+			switch v := v.(*GetLocationsRankingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1915,7 +2752,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatVarsRequest); i {
+			switch v := v.(*GetLocationsRankingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1927,7 +2764,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatVarsResponse); i {
+			switch v := v.(*StatsVars); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1939,7 +2776,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatVarsUnionRequest); i {
+			switch v := v.(*GetPlaceStatsVarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1951,7 +2788,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatVarsUnionResponse); i {
+			switch v := v.(*GetPlaceStatsVarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1963,7 +2800,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatDateWithinPlaceRequest); i {
+			switch v := v.(*StatVars); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1975,7 +2812,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatDateWithinPlaceResponse); i {
+			switch v := v.(*GetPlaceStatVarsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1987,7 +2824,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaceMetadataCache); i {
+			switch v := v.(*GetPlaceStatVarsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1999,7 +2836,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaceMetadata); i {
+			switch v := v.(*GetPlaceStatVarsUnionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2011,7 +2848,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceMetadataRequest); i {
+			switch v := v.(*GetPlaceStatVarsUnionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2023,7 +2860,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceMetadataResponse); i {
+			switch v := v.(*GetPlaceStatDateWithinPlaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2035,7 +2872,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelatedPlacesInfo_Ranking); i {
+			switch v := v.(*GetPlaceStatDateWithinPlaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2047,7 +2884,55 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelatedPlacesInfo_Ranking_RankInfo); i {
+			switch v := v.(*PlaceMetadataCache); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_place_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_place_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaceMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_place_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaceMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_place_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCoordinatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2059,7 +2944,7 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaceMetadataCache_PlaceInfo); i {
+			switch v := v.(*ResolveCoordinatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2071,6 +2956,42 @@ func file_place_proto_init() {
 			}
 		}
 		file_place_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelatedPlacesInfo_Ranking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_place_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelatedPlacesInfo_Ranking_RankInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_place_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceMetadataCache_PlaceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_place_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlaceMetadata_PlaceInfo); i {
 			case 0:
 				return &v.state
@@ -2082,6 +3003,30 @@ func file_place_proto_init() {
 				return nil
 			}
 		}
+		file_place_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCoordinatesRequest_Coordinate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_place_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCoordinatesResponse_PlaceCoordinate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2089,7 +3034,7 @@ func file_place_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_place_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -64,6 +64,9 @@ type StatMetadata struct {
 	ObservationPeriod string `protobuf:"bytes,4,opt,name=observation_period,json=observationPeriod,proto3" json:"observation_period,omitempty"`
 	ScalingFactor     string `protobuf:"bytes,5,opt,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty"`
 	Unit              string `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Whether the data is computed by Data Commons, like aggregation from child
+	// places.
+	IsDcAggregate bool `protobuf:"varint,7,opt,name=is_dc_aggregate,json=isDcAggregate,proto3" json:"is_dc_aggregate,omitempty"`
 }
 
 func (x *StatMetadata) Reset() {
@@ -140,6 +143,13 @@ func (x *StatMetadata) GetUnit() string {
 	return ""
 }
 
+func (x *StatMetadata) GetIsDcAggregate() bool {
+	if x != nil {
+		return x.IsDcAggregate
+	}
+	return false
+}
+
 type PointStat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -155,6 +165,13 @@ type PointStat struct {
 	// the hash to the full metatdata. This is set in /stat/set/within-place/*
 	// APIs.
 	MetaHash uint32 `protobuf:"varint,4,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+	// Hash of the denominator metadata, set when the value is a ratio to a
+	// denominator stat var.
+	DenominatorMetaHash uint32 `protobuf:"varint,5,opt,name=denominator_meta_hash,json=denominatorMetaHash,proto3" json:"denominator_meta_hash,omitempty"`
+	// Date of the denominator observation used for the ratio.
+	DenominatorDate string `protobuf:"bytes,6,opt,name=denominator_date,json=denominatorDate,proto3" json:"denominator_date,omitempty"`
+	// Base date of the value when it is a change between two dates.
+	BaseDate string `protobuf:"bytes,7,opt,name=base_date,json=baseDate,proto3" json:"base_date,omitempty"`
 }
 
 func (x *PointStat) Reset() {
@@ -217,6 +234,27 @@ func (x *PointStat) GetMetaHash() uint32 {
 	return 0
 }
 
+func (x *PointStat) GetDenominatorMetaHash() uint32 {
+	if x != nil {
+		return x.DenominatorMetaHash
+	}
+	return 0
+}
+
+func (x *PointStat) GetDenominatorDate() string {
+	if x != nil {
+		return x.DenominatorDate
+	}
+	return ""
+}
+
+func (x *PointStat) GetBaseDate() string {
+	if x != nil {
+		return x.BaseDate
+	}
+	return ""
+}
+
 type PlacePointStat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -452,6 +490,17 @@ type Series struct {
 	Val map[string]float64 `protobuf:"bytes,1,rep,name=val,proto3" json:"val,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 	// Series metadata.
 	Metadata *StatMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Metadata of the denominator series, set when the values are ratios to a
+	// denominator stat var.
+	DenominatorMetadata *StatMetadata `protobuf:"bytes,3,opt,name=denominator_metadata,json=denominatorMetadata,proto3" json:"denominator_metadata,omitempty"`
+	// Dates of the values filled by gap filling, not observed.
+	FilledDates []string `protobuf:"bytes,4,rep,name=filled_dates,json=filledDates,proto3" json:"filled_dates,omitempty"`
+	// Metadata hash of each date, set when the series is stitched from
+	// multiple sources. Keyed by date.
+	MetaHashes map[string]uint32 `protobuf:"bytes,5,rep,name=meta_hashes,json=metaHashes,proto3" json:"meta_hashes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Metadata hash of the series, set in place of metadata when the metadata
+	// is given in a separate metadata map.
+	MetaHash uint32 `protobuf:"varint,6,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
 }
 
 func (x *Series) Reset() {
@@ -500,6 +549,34 @@ func (x *Series) GetMetadata() *StatMetadata {
 	return nil
 }
 
+func (x *Series) GetDenominatorMetadata() *StatMetadata {
+	if x != nil {
+		return x.DenominatorMetadata
+	}
+	return nil
+}
+
+func (x *Series) GetFilledDates() []string {
+	if x != nil {
+		return x.FilledDates
+	}
+	return nil
+}
+
+func (x *Series) GetMetaHashes() map[string]uint32 {
+	if x != nil {
+		return x.MetaHashes
+	}
+	return nil
+}
+
+func (x *Series) GetMetaHash() uint32 {
+	if x != nil {
+		return x.MetaHash
+	}
+	return 0
+}
+
 type SeriesMap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -548,6 +625,102 @@ func (x *SeriesMap) GetData() map[string]*Series {
 	return nil
 }
 
+// A list of series for one <place, stat var>.
+type SeriesList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Series []*Series `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+}
+
+func (x *SeriesList) Reset() {
+	*x = SeriesList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeriesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesList) ProtoMessage() {}
+
+func (x *SeriesList) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesList.ProtoReflect.Descriptor instead.
+func (*SeriesList) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SeriesList) GetSeries() []*Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// Map from stat var dcid to the series list of the stat var.
+type SeriesListMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data map[string]*SeriesList `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SeriesListMap) Reset() {
+	*x = SeriesListMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeriesListMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesListMap) ProtoMessage() {}
+
+func (x *SeriesListMap) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesListMap.ProtoReflect.Descriptor instead.
+func (*SeriesListMap) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SeriesListMap) GetData() map[string]*SeriesList {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // Represents observation time series data.
 type ObsTimeSeries struct {
 	state         protoimpl.MessageState
@@ -565,7 +738,7 @@ type ObsTimeSeries struct {
 func (x *ObsTimeSeries) Reset() {
 	*x = ObsTimeSeries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[7]
+		mi := &file_stat_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -578,7 +751,7 @@ func (x *ObsTimeSeries) String() string {
 func (*ObsTimeSeries) ProtoMessage() {}
 
 func (x *ObsTimeSeries) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[7]
+	mi := &file_stat_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +764,7 @@ func (x *ObsTimeSeries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObsTimeSeries.ProtoReflect.Descriptor instead.
 func (*ObsTimeSeries) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{7}
+	return file_stat_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ObsTimeSeries) GetData() map[string]float64 {
@@ -650,7 +823,7 @@ type ObsCollection struct {
 func (x *ObsCollection) Reset() {
 	*x = ObsCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[8]
+		mi := &file_stat_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -663,7 +836,7 @@ func (x *ObsCollection) String() string {
 func (*ObsCollection) ProtoMessage() {}
 
 func (x *ObsCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[8]
+	mi := &file_stat_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +849,7 @@ func (x *ObsCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObsCollection.ProtoReflect.Descriptor instead.
 func (*ObsCollection) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{8}
+	return file_stat_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ObsCollection) GetSourceCohorts() []*SourceSeries {
@@ -701,7 +874,7 @@ type ChartStore struct {
 func (x *ChartStore) Reset() {
 	*x = ChartStore{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[9]
+		mi := &file_stat_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -714,7 +887,7 @@ func (x *ChartStore) String() string {
 func (*ChartStore) ProtoMessage() {}
 
 func (x *ChartStore) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[9]
+	mi := &file_stat_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +900,7 @@ func (x *ChartStore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChartStore.ProtoReflect.Descriptor instead.
 func (*ChartStore) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{9}
+	return file_stat_proto_rawDescGZIP(), []int{11}
 }
 
 func (m *ChartStore) GetVal() isChartStore_Val {
@@ -781,7 +954,7 @@ type PlaceStat struct {
 func (x *PlaceStat) Reset() {
 	*x = PlaceStat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[10]
+		mi := &file_stat_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -794,7 +967,7 @@ func (x *PlaceStat) String() string {
 func (*PlaceStat) ProtoMessage() {}
 
 func (x *PlaceStat) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[10]
+	mi := &file_stat_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,7 +980,7 @@ func (x *PlaceStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceStat.ProtoReflect.Descriptor instead.
 func (*PlaceStat) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{10}
+	return file_stat_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PlaceStat) GetStatVarData() map[string]*ObsTimeSeries {
@@ -831,7 +1004,7 @@ type StatVarObsSeries struct {
 func (x *StatVarObsSeries) Reset() {
 	*x = StatVarObsSeries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[11]
+		mi := &file_stat_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -844,7 +1017,7 @@ func (x *StatVarObsSeries) String() string {
 func (*StatVarObsSeries) ProtoMessage() {}
 
 func (x *StatVarObsSeries) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[11]
+	mi := &file_stat_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1030,7 @@ func (x *StatVarObsSeries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatVarObsSeries.ProtoReflect.Descriptor instead.
 func (*StatVarObsSeries) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{11}
+	return file_stat_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *StatVarObsSeries) GetData() map[string]*ObsTimeSeries {
@@ -882,7 +1055,7 @@ type StatVarSeries struct {
 func (x *StatVarSeries) Reset() {
 	*x = StatVarSeries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[12]
+		mi := &file_stat_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -895,7 +1068,7 @@ func (x *StatVarSeries) String() string {
 func (*StatVarSeries) ProtoMessage() {}
 
 func (x *StatVarSeries) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[12]
+	mi := &file_stat_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1081,7 @@ func (x *StatVarSeries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatVarSeries.ProtoReflect.Descriptor instead.
 func (*StatVarSeries) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{12}
+	return file_stat_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StatVarSeries) GetData() map[string]*Series {
@@ -939,7 +1112,7 @@ type SVOPlace struct {
 func (x *SVOPlace) Reset() {
 	*x = SVOPlace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[13]
+		mi := &file_stat_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -952,7 +1125,7 @@ func (x *SVOPlace) String() string {
 func (*SVOPlace) ProtoMessage() {}
 
 func (x *SVOPlace) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[13]
+	mi := &file_stat_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1138,7 @@ func (x *SVOPlace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SVOPlace.ProtoReflect.Descriptor instead.
 func (*SVOPlace) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{13}
+	return file_stat_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SVOPlace) GetName() string {
@@ -1035,7 +1208,7 @@ type SVOObservation struct {
 func (x *SVOObservation) Reset() {
 	*x = SVOObservation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[14]
+		mi := &file_stat_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1048,7 +1221,7 @@ func (x *SVOObservation) String() string {
 func (*SVOObservation) ProtoMessage() {}
 
 func (x *SVOObservation) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[14]
+	mi := &file_stat_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,7 +1234,7 @@ func (x *SVOObservation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SVOObservation.ProtoReflect.Descriptor instead.
 func (*SVOObservation) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{14}
+	return file_stat_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SVOObservation) GetDcid() string {
@@ -1171,7 +1344,7 @@ type SVOCollection struct {
 func (x *SVOCollection) Reset() {
 	*x = SVOCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[15]
+		mi := &file_stat_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1184,7 +1357,7 @@ func (x *SVOCollection) String() string {
 func (*SVOCollection) ProtoMessage() {}
 
 func (x *SVOCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[15]
+	mi := &file_stat_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,7 +1370,7 @@ func (x *SVOCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SVOCollection.ProtoReflect.Descriptor instead.
 func (*SVOCollection) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{15}
+	return file_stat_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SVOCollection) GetPlaces() []*SVOPlace {
@@ -1231,7 +1404,7 @@ type GetStatsRequest struct {
 func (x *GetStatsRequest) Reset() {
 	*x = GetStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[16]
+		mi := &file_stat_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1244,7 +1417,7 @@ func (x *GetStatsRequest) String() string {
 func (*GetStatsRequest) ProtoMessage() {}
 
 func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[16]
+	mi := &file_stat_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1257,7 +1430,7 @@ func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{16}
+	return file_stat_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetStatsRequest) GetPlace() []string {
@@ -1308,7 +1481,7 @@ type GetStatsResponse struct {
 func (x *GetStatsResponse) Reset() {
 	*x = GetStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[17]
+		mi := &file_stat_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1321,7 +1494,7 @@ func (x *GetStatsResponse) String() string {
 func (*GetStatsResponse) ProtoMessage() {}
 
 func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[17]
+	mi := &file_stat_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,7 +1507,7 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{17}
+	return file_stat_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetStatsResponse) GetPayload() string {
@@ -1358,14 +1531,77 @@ type GetStatSetSeriesRequest struct {
 	// The dcids of the statistical variables.
 	StatVars []string `protobuf:"bytes,2,rep,name=stat_vars,json=statVars,proto3" json:"stat_vars,omitempty"`
 	// (Optional) Import name of the desired series.
-	// TODO(shifucun): consider add other SVObs properties for filtering.
 	ImportName string `protobuf:"bytes,3,opt,name=import_name,json=importName,proto3" json:"import_name,omitempty"`
+	// (Optional) Start date of the observations, inclusive, in ISO 8601 format.
+	StartDate string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// (Optional) End date of the observations, inclusive, in ISO 8601 format.
+	// A coarser end date includes its sub-periods, like "2020" includes
+	// "2020-12-31".
+	EndDate string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// (Optional) Date granularity of the observations, one of "year", "month"
+	// and "day". Observations of other granularity are dropped.
+	Granularity string `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	// (Optional) Dcid of a denominator stat var, like "Count_Person". When set,
+	// each value is divided by the denominator observation of the same place
+	// with the closest date, from the best ranked denominator source after
+	// preferred_imports and excluded_imports.
+	// The values are divided before the transform, so the transform is computed
+	// on the ratios.
+	Denominator string `protobuf:"bytes,7,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	// (Optional) Resample the observations to a coarser granularity, one of
+	// "year" and "month". Observations finer than the target are aggregated,
+	// coarser ones are dropped.
+	Resample string `protobuf:"bytes,8,opt,name=resample,proto3" json:"resample,omitempty"`
+	// (Optional) Aggregation method of resampling, one of "sum", "mean", "last",
+	// "min" and "max". Defaults to the method implied by the stat var statType,
+	// or "last".
+	// With "sum", periods missing some of their observations are dropped.
+	Aggregation string `protobuf:"bytes,9,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// (Optional) Gap filling mode of the series, one of "none", "carry_forward" and
+	// "linear". Missing dates between the first and last observation, at the
+	// granularity of the series, are filled and listed in filled_dates.
+	Fill string `protobuf:"bytes,10,opt,name=fill,proto3" json:"fill,omitempty"`
+	// (Optional) Derived series transform, one of "diff", "yoy_pct" and "cagr".
+	// "diff" and "cagr" compare each observation with the one transform_periods
+	// observations earlier, with "cagr" annualized by the actual elapsed time.
+	// "yoy_pct" compares with the same date transform_periods years earlier.
+	Transform string `protobuf:"bytes,11,opt,name=transform,proto3" json:"transform,omitempty"`
+	// (Optional) Number of periods of the transform, defaults to 1.
+	TransformPeriods int32 `protobuf:"varint,12,opt,name=transform_periods,json=transformPeriods,proto3" json:"transform_periods,omitempty"`
+	// (Optional) Stitch the series from multiple sources. Dates missing in the
+	// top ranked source are filled from lower ranked sources with the same unit,
+	// scaling factor, observation period and measurement method, and the source
+	// of each date is given in meta_hashes.
+	// Ignored when import_name is set, and can not be set with all_sources.
+	Stitch bool `protobuf:"varint,13,opt,name=stitch,proto3" json:"stitch,omitempty"`
+	// (Optional) Return all the series matching the filters in all_data,
+	// ranked from the best source, instead of the best series in data.
+	AllSources bool `protobuf:"varint,14,opt,name=all_sources,json=allSources,proto3" json:"all_sources,omitempty"`
+	// (Optional) Filters on the series metadata, applied to both the best series
+	// and all sources.
+	MeasurementMethod string `protobuf:"bytes,15,opt,name=measurement_method,json=measurementMethod,proto3" json:"measurement_method,omitempty"`
+	ObservationPeriod string `protobuf:"bytes,16,opt,name=observation_period,json=observationPeriod,proto3" json:"observation_period,omitempty"`
+	Unit              string `protobuf:"bytes,17,opt,name=unit,proto3" json:"unit,omitempty"`
+	ScalingFactor     string `protobuf:"bytes,18,opt,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty"`
+	// (Optional) "true" to only keep Data Commons aggregated series, "false" to
+	// exclude them.
+	IsDcAggregate string `protobuf:"bytes,19,opt,name=is_dc_aggregate,json=isDcAggregate,proto3" json:"is_dc_aggregate,omitempty"`
+	// (Optional) Convert the values to the unit, applying the scaling factor.
+	// Series whose unit can not be converted are dropped.
+	TargetUnit string `protobuf:"bytes,20,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
+	// (Optional) Import names preferred over the default source ranking, from the
+	// most preferred.
+	PreferredImports []string `protobuf:"bytes,21,rep,name=preferred_imports,json=preferredImports,proto3" json:"preferred_imports,omitempty"`
+	// (Optional) Import names excluded from the sources.
+	ExcludedImports []string `protobuf:"bytes,22,rep,name=excluded_imports,json=excludedImports,proto3" json:"excluded_imports,omitempty"`
+	// (Optional) Also stitch sources of other measurement methods.
+	StitchMeasurementMethods bool `protobuf:"varint,23,opt,name=stitch_measurement_methods,json=stitchMeasurementMethods,proto3" json:"stitch_measurement_methods,omitempty"`
 }
 
 func (x *GetStatSetSeriesRequest) Reset() {
 	*x = GetStatSetSeriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[18]
+		mi := &file_stat_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1378,7 +1614,7 @@ func (x *GetStatSetSeriesRequest) String() string {
 func (*GetStatSetSeriesRequest) ProtoMessage() {}
 
 func (x *GetStatSetSeriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[18]
+	mi := &file_stat_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,7 +1627,7 @@ func (x *GetStatSetSeriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatSetSeriesRequest.ProtoReflect.Descriptor instead.
 func (*GetStatSetSeriesRequest) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{18}
+	return file_stat_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetStatSetSeriesRequest) GetPlaces() []string {
@@ -1415,67 +1651,226 @@ func (x *GetStatSetSeriesRequest) GetImportName() string {
 	return ""
 }
 
-// Response of GetStatSetSeries
-type GetStatSetSeriesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetStatSetSeriesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
 
-	// A map from place dcid to series map.
-	Data map[string]*SeriesMap `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *GetStatSetSeriesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
 }
 
-func (x *GetStatSetSeriesResponse) Reset() {
-	*x = GetStatSetSeriesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetStatSetSeriesRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
 	}
+	return ""
 }
 
-func (x *GetStatSetSeriesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetStatSetSeriesRequest) GetDenominator() string {
+	if x != nil {
+		return x.Denominator
+	}
+	return ""
 }
 
-func (*GetStatSetSeriesResponse) ProtoMessage() {}
+func (x *GetStatSetSeriesRequest) GetResample() string {
+	if x != nil {
+		return x.Resample
+	}
+	return ""
+}
 
-func (x *GetStatSetSeriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetStatSetSeriesRequest) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetStatSetSeriesResponse.ProtoReflect.Descriptor instead.
-func (*GetStatSetSeriesResponse) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{19}
+func (x *GetStatSetSeriesRequest) GetFill() string {
+	if x != nil {
+		return x.Fill
+	}
+	return ""
 }
 
-func (x *GetStatSetSeriesResponse) GetData() map[string]*SeriesMap {
+func (x *GetStatSetSeriesRequest) GetTransform() string {
 	if x != nil {
-		return x.Data
+		return x.Transform
 	}
-	return nil
+	return ""
 }
 
-// Request for GetStat service.
-type GetStatValueRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetStatSetSeriesRequest) GetTransformPeriods() int32 {
+	if x != nil {
+		return x.TransformPeriods
+	}
+	return 0
+}
 
-	// dcid of the place.
-	Place string `protobuf:"bytes,1,opt,name=place,proto3" json:"place,omitempty"`
-	// dcid of the stat var.
-	StatVar string `protobuf:"bytes,2,opt,name=stat_var,json=statVar,proto3" json:"stat_var,omitempty"`
-	// (optional) date of the stat. The latest date will be used if unspecified.
-	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+func (x *GetStatSetSeriesRequest) GetStitch() bool {
+	if x != nil {
+		return x.Stitch
+	}
+	return false
+}
+
+func (x *GetStatSetSeriesRequest) GetAllSources() bool {
+	if x != nil {
+		return x.AllSources
+	}
+	return false
+}
+
+func (x *GetStatSetSeriesRequest) GetMeasurementMethod() string {
+	if x != nil {
+		return x.MeasurementMethod
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesRequest) GetObservationPeriod() string {
+	if x != nil {
+		return x.ObservationPeriod
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesRequest) GetScalingFactor() string {
+	if x != nil {
+		return x.ScalingFactor
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesRequest) GetIsDcAggregate() string {
+	if x != nil {
+		return x.IsDcAggregate
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesRequest) GetPreferredImports() []string {
+	if x != nil {
+		return x.PreferredImports
+	}
+	return nil
+}
+
+func (x *GetStatSetSeriesRequest) GetExcludedImports() []string {
+	if x != nil {
+		return x.ExcludedImports
+	}
+	return nil
+}
+
+func (x *GetStatSetSeriesRequest) GetStitchMeasurementMethods() bool {
+	if x != nil {
+		return x.StitchMeasurementMethods
+	}
+	return false
+}
+
+// Response of GetStatSetSeries
+type GetStatSetSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A map from place dcid to series map.
+	Data map[string]*SeriesMap `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Metadata of stitched series and all source series, keyed by metadata hash.
+	Metadata map[uint32]*StatMetadata `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// A map from place dcid to the series of all sources, set when all_sources
+	// is true. The metadata of each series is given by meta_hash.
+	AllData map[string]*SeriesListMap `protobuf:"bytes,3,rep,name=all_data,json=allData,proto3" json:"all_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetStatSetSeriesResponse) Reset() {
+	*x = GetStatSetSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatSetSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatSetSeriesResponse) ProtoMessage() {}
+
+func (x *GetStatSetSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatSetSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetStatSetSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetStatSetSeriesResponse) GetData() map[string]*SeriesMap {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetStatSetSeriesResponse) GetMetadata() map[uint32]*StatMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetStatSetSeriesResponse) GetAllData() map[string]*SeriesListMap {
+	if x != nil {
+		return x.AllData
+	}
+	return nil
+}
+
+// Request for GetStat service.
+type GetStatValueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// dcid of the place.
+	Place string `protobuf:"bytes,1,opt,name=place,proto3" json:"place,omitempty"`
+	// dcid of the stat var.
+	StatVar string `protobuf:"bytes,2,opt,name=stat_var,json=statVar,proto3" json:"stat_var,omitempty"`
+	// (optional) date of the stat. The latest date will be used if unspecified.
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 	// (optional) measurement method of the observation, ex: "CensusACS5yrSurvey".
 	MeasurementMethod string `protobuf:"bytes,4,opt,name=measurement_method,json=measurementMethod,proto3" json:"measurement_method,omitempty"`
 	// (optional) observation period of the observation, ex: "P1Y".
@@ -1484,12 +1879,23 @@ type GetStatValueRequest struct {
 	Unit string `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
 	// (optional) scaling factor of the observation.
 	ScalingFactor string `protobuf:"bytes,7,opt,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty"`
+	// (optional) Change between base_date and date, one of "diff", "yoy_pct" (percent
+	// change) and "cagr" (compound annual growth rate in percent). Both values
+	// come from the same source.
+	Transform string `protobuf:"bytes,8,opt,name=transform,proto3" json:"transform,omitempty"`
+	// (optional) Base date of the transform, required when transform is set.
+	BaseDate string `protobuf:"bytes,9,opt,name=base_date,json=baseDate,proto3" json:"base_date,omitempty"`
+	// (optional) Import names preferred over the default source ranking, from the
+	// most preferred.
+	PreferredImports []string `protobuf:"bytes,10,rep,name=preferred_imports,json=preferredImports,proto3" json:"preferred_imports,omitempty"`
+	// (optional) Import names excluded from the sources.
+	ExcludedImports []string `protobuf:"bytes,11,rep,name=excluded_imports,json=excludedImports,proto3" json:"excluded_imports,omitempty"`
 }
 
 func (x *GetStatValueRequest) Reset() {
 	*x = GetStatValueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[20]
+		mi := &file_stat_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1502,7 +1908,7 @@ func (x *GetStatValueRequest) String() string {
 func (*GetStatValueRequest) ProtoMessage() {}
 
 func (x *GetStatValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[20]
+	mi := &file_stat_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,7 +1921,7 @@ func (x *GetStatValueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatValueRequest.ProtoReflect.Descriptor instead.
 func (*GetStatValueRequest) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{20}
+	return file_stat_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetStatValueRequest) GetPlace() string {
@@ -1567,6 +1973,34 @@ func (x *GetStatValueRequest) GetScalingFactor() string {
 	return ""
 }
 
+func (x *GetStatValueRequest) GetTransform() string {
+	if x != nil {
+		return x.Transform
+	}
+	return ""
+}
+
+func (x *GetStatValueRequest) GetBaseDate() string {
+	if x != nil {
+		return x.BaseDate
+	}
+	return ""
+}
+
+func (x *GetStatValueRequest) GetPreferredImports() []string {
+	if x != nil {
+		return x.PreferredImports
+	}
+	return nil
+}
+
+func (x *GetStatValueRequest) GetExcludedImports() []string {
+	if x != nil {
+		return x.ExcludedImports
+	}
+	return nil
+}
+
 type GetStatValueResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1578,7 +2012,7 @@ type GetStatValueResponse struct {
 func (x *GetStatValueResponse) Reset() {
 	*x = GetStatValueResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[21]
+		mi := &file_stat_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1591,7 +2025,7 @@ func (x *GetStatValueResponse) String() string {
 func (*GetStatValueResponse) ProtoMessage() {}
 
 func (x *GetStatValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[21]
+	mi := &file_stat_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,7 +2038,7 @@ func (x *GetStatValueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatValueResponse.ProtoReflect.Descriptor instead.
 func (*GetStatValueResponse) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{21}
+	return file_stat_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetStatValueResponse) GetValue() float64 {
@@ -1632,12 +2066,49 @@ type GetStatSeriesRequest struct {
 	Unit string `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
 	// (optional) scaling factor of the observation.
 	ScalingFactor string `protobuf:"bytes,6,opt,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty"`
+	// (optional) Start date of the observations, inclusive, in ISO 8601 format.
+	StartDate string `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// (optional) End date of the observations, inclusive, in ISO 8601 format.
+	// A coarser end date includes its sub-periods, like "2020" includes
+	// "2020-12-31".
+	EndDate string `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// (optional) Date granularity of the observations, one of "year", "month"
+	// and "day". Observations of other granularity are dropped.
+	Granularity string `protobuf:"bytes,9,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	// (optional) Resample the observations to a coarser granularity, one of
+	// "year" and "month". Observations finer than the target are aggregated,
+	// coarser ones are dropped.
+	Resample string `protobuf:"bytes,10,opt,name=resample,proto3" json:"resample,omitempty"`
+	// (optional) Aggregation method of resampling, one of "sum", "mean", "last",
+	// "min" and "max". Defaults to the method implied by the stat var statType,
+	// or "last".
+	// With "sum", periods missing some of their observations are dropped.
+	Aggregation string `protobuf:"bytes,11,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// (optional) Gap filling mode of the series, one of "none", "carry_forward" and
+	// "linear". Missing dates between the first and last observation, at the
+	// granularity of the series, are filled and listed in filled_dates.
+	Fill string `protobuf:"bytes,12,opt,name=fill,proto3" json:"fill,omitempty"`
+	// (optional) Derived series transform, one of "diff", "yoy_pct" and "cagr".
+	// "diff" and "cagr" compare each observation with the one transform_periods
+	// observations earlier, with "cagr" annualized by the actual elapsed time.
+	// "yoy_pct" compares with the same date transform_periods years earlier.
+	Transform string `protobuf:"bytes,13,opt,name=transform,proto3" json:"transform,omitempty"`
+	// (optional) Number of periods of the transform, defaults to 1.
+	TransformPeriods int32 `protobuf:"varint,14,opt,name=transform_periods,json=transformPeriods,proto3" json:"transform_periods,omitempty"`
+	// (optional) Convert the values to the unit, applying the scaling factor.
+	// Series whose unit can not be converted are dropped.
+	TargetUnit string `protobuf:"bytes,15,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
+	// (optional) Import names preferred over the default source ranking, from the
+	// most preferred.
+	PreferredImports []string `protobuf:"bytes,16,rep,name=preferred_imports,json=preferredImports,proto3" json:"preferred_imports,omitempty"`
+	// (optional) Import names excluded from the sources.
+	ExcludedImports []string `protobuf:"bytes,17,rep,name=excluded_imports,json=excludedImports,proto3" json:"excluded_imports,omitempty"`
 }
 
 func (x *GetStatSeriesRequest) Reset() {
 	*x = GetStatSeriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[22]
+		mi := &file_stat_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1650,7 +2121,7 @@ func (x *GetStatSeriesRequest) String() string {
 func (*GetStatSeriesRequest) ProtoMessage() {}
 
 func (x *GetStatSeriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[22]
+	mi := &file_stat_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,7 +2134,7 @@ func (x *GetStatSeriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatSeriesRequest.ProtoReflect.Descriptor instead.
 func (*GetStatSeriesRequest) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{22}
+	return file_stat_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetStatSeriesRequest) GetPlace() string {
@@ -1708,6 +2179,83 @@ func (x *GetStatSeriesRequest) GetScalingFactor() string {
 	return ""
 }
 
+func (x *GetStatSeriesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetStatSeriesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetStatSeriesRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetStatSeriesRequest) GetResample() string {
+	if x != nil {
+		return x.Resample
+	}
+	return ""
+}
+
+func (x *GetStatSeriesRequest) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
+func (x *GetStatSeriesRequest) GetFill() string {
+	if x != nil {
+		return x.Fill
+	}
+	return ""
+}
+
+func (x *GetStatSeriesRequest) GetTransform() string {
+	if x != nil {
+		return x.Transform
+	}
+	return ""
+}
+
+func (x *GetStatSeriesRequest) GetTransformPeriods() int32 {
+	if x != nil {
+		return x.TransformPeriods
+	}
+	return 0
+}
+
+func (x *GetStatSeriesRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
+func (x *GetStatSeriesRequest) GetPreferredImports() []string {
+	if x != nil {
+		return x.PreferredImports
+	}
+	return nil
+}
+
+func (x *GetStatSeriesRequest) GetExcludedImports() []string {
+	if x != nil {
+		return x.ExcludedImports
+	}
+	return nil
+}
+
 // Response for GetStatSeries service.
 type GetStatSeriesResponse struct {
 	state         protoimpl.MessageState
@@ -1716,12 +2264,14 @@ type GetStatSeriesResponse struct {
 
 	// A map from ISO date to stat value.
 	Series map[string]float64 `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Dates of the values filled by gap filling, not observed.
+	FilledDates []string `protobuf:"bytes,2,rep,name=filled_dates,json=filledDates,proto3" json:"filled_dates,omitempty"`
 }
 
 func (x *GetStatSeriesResponse) Reset() {
 	*x = GetStatSeriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[23]
+		mi := &file_stat_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1734,7 +2284,7 @@ func (x *GetStatSeriesResponse) String() string {
 func (*GetStatSeriesResponse) ProtoMessage() {}
 
 func (x *GetStatSeriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[23]
+	mi := &file_stat_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,7 +2297,7 @@ func (x *GetStatSeriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatSeriesResponse.ProtoReflect.Descriptor instead.
 func (*GetStatSeriesResponse) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{23}
+	return file_stat_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetStatSeriesResponse) GetSeries() map[string]float64 {
@@ -1757,6 +2307,13 @@ func (x *GetStatSeriesResponse) GetSeries() map[string]float64 {
 	return nil
 }
 
+func (x *GetStatSeriesResponse) GetFilledDates() []string {
+	if x != nil {
+		return x.FilledDates
+	}
+	return nil
+}
+
 // Request for GetStatAll service.
 type GetStatAllRequest struct {
 	state         protoimpl.MessageState
@@ -1767,12 +2324,35 @@ type GetStatAllRequest struct {
 	Places []string `protobuf:"bytes,1,rep,name=places,proto3" json:"places,omitempty"`
 	// dcids of the stat var.
 	StatVars []string `protobuf:"bytes,2,rep,name=stat_vars,json=statVars,proto3" json:"stat_vars,omitempty"`
+	// (Optional) Start date of the observations, inclusive, in ISO 8601 format.
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// (Optional) End date of the observations, inclusive, in ISO 8601 format.
+	// A coarser end date includes its sub-periods, like "2020" includes
+	// "2020-12-31".
+	EndDate string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// (Optional) Date granularity of the observations, one of "year", "month"
+	// and "day". Observations of other granularity are dropped.
+	Granularity string `protobuf:"bytes,5,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	// (Optional) Resample the observations to a coarser granularity, one of
+	// "year" and "month". Observations finer than the target are aggregated,
+	// coarser ones are dropped.
+	Resample string `protobuf:"bytes,6,opt,name=resample,proto3" json:"resample,omitempty"`
+	// (Optional) Aggregation method of resampling, one of "sum", "mean", "last",
+	// "min" and "max". Defaults to the method implied by the stat var statType,
+	// or "last".
+	// With "sum", periods missing some of their observations are dropped.
+	Aggregation string `protobuf:"bytes,7,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// (Optional) Import names preferred over the default source ranking, from the
+	// most preferred.
+	PreferredImports []string `protobuf:"bytes,8,rep,name=preferred_imports,json=preferredImports,proto3" json:"preferred_imports,omitempty"`
+	// (Optional) Import names excluded from the sources.
+	ExcludedImports []string `protobuf:"bytes,9,rep,name=excluded_imports,json=excludedImports,proto3" json:"excluded_imports,omitempty"`
 }
 
 func (x *GetStatAllRequest) Reset() {
 	*x = GetStatAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[24]
+		mi := &file_stat_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1785,7 +2365,7 @@ func (x *GetStatAllRequest) String() string {
 func (*GetStatAllRequest) ProtoMessage() {}
 
 func (x *GetStatAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[24]
+	mi := &file_stat_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1798,7 +2378,7 @@ func (x *GetStatAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatAllRequest.ProtoReflect.Descriptor instead.
 func (*GetStatAllRequest) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{24}
+	return file_stat_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetStatAllRequest) GetPlaces() []string {
@@ -1815,19 +2395,68 @@ func (x *GetStatAllRequest) GetStatVars() []string {
 	return nil
 }
 
-// Response for GetStatAll service.
-//
-// The response is a two level map, with the first level keyed by place dcid,
-// and the second level keyed by the stat var dcid.
-// Each leaf object contains multiple source series with <date, value> object
-// and observation metadata.
-//
-// The response is transcoded by esp:
-// https://cloud.google.com/endpoints/docs/grpc/grpc-service-config Example
-// response after esp transcoding is like below.
-// {
-//   placeData: {
-//     "geoId/01": {
+func (x *GetStatAllRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetStatAllRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetStatAllRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetStatAllRequest) GetResample() string {
+	if x != nil {
+		return x.Resample
+	}
+	return ""
+}
+
+func (x *GetStatAllRequest) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
+func (x *GetStatAllRequest) GetPreferredImports() []string {
+	if x != nil {
+		return x.PreferredImports
+	}
+	return nil
+}
+
+func (x *GetStatAllRequest) GetExcludedImports() []string {
+	if x != nil {
+		return x.ExcludedImports
+	}
+	return nil
+}
+
+// Response for GetStatAll service.
+//
+// The response is a two level map, with the first level keyed by place dcid,
+// and the second level keyed by the stat var dcid.
+// Each leaf object contains multiple source series with <date, value> object
+// and observation metadata.
+//
+// The response is transcoded by esp:
+// https://cloud.google.com/endpoints/docs/grpc/grpc-service-config Example
+// response after esp transcoding is like below.
+// {
+//   placeData: {
+//     "geoId/01": {
 //       statVarData: {
 //         "statvar1": {
 //           "placeName": "City of Mountain View",
@@ -1871,7 +2500,7 @@ type GetStatAllResponse struct {
 func (x *GetStatAllResponse) Reset() {
 	*x = GetStatAllResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[25]
+		mi := &file_stat_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1884,7 +2513,7 @@ func (x *GetStatAllResponse) String() string {
 func (*GetStatAllResponse) ProtoMessage() {}
 
 func (x *GetStatAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[25]
+	mi := &file_stat_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1897,7 +2526,7 @@ func (x *GetStatAllResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatAllResponse.ProtoReflect.Descriptor instead.
 func (*GetStatAllResponse) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{25}
+	return file_stat_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetStatAllResponse) GetPlaceData() map[string]*PlaceStat {
@@ -1922,134 +2551,993 @@ type GetStatSetWithinPlaceRequest struct {
 	// If the date is not given, then the latest observation for each place is
 	// returned, where they could be from different sources.
 	Date string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// (Optional) Dcid of a denominator stat var, like "Count_Person". When set,
+	// each value is divided by the denominator observation of the same place
+	// with the closest date, from the best ranked denominator source after
+	// preferred_imports and excluded_imports.
+	// Not supported by GetStatSetWithinPlaceAll.
+	Denominator string `protobuf:"bytes,5,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	// (Optional) Import names preferred over the default source ranking, from the
+	// most preferred.
+	PreferredImports []string `protobuf:"bytes,6,rep,name=preferred_imports,json=preferredImports,proto3" json:"preferred_imports,omitempty"`
+	// (Optional) Import names excluded from the sources.
+	ExcludedImports []string `protobuf:"bytes,7,rep,name=excluded_imports,json=excludedImports,proto3" json:"excluded_imports,omitempty"`
+	// (Optional) Place set to use instead of parent_place and child_type.
+	// Requests selecting the children of a single parent place and child type
+	// are served from the precomputed collection.
+	PlaceSelector *PlaceSelector `protobuf:"bytes,8,opt,name=place_selector,json=placeSelector,proto3" json:"place_selector,omitempty"`
 }
 
 func (x *GetStatSetWithinPlaceRequest) Reset() {
 	*x = GetStatSetWithinPlaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[26]
+		mi := &file_stat_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatSetWithinPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatSetWithinPlaceRequest) ProtoMessage() {}
+
+func (x *GetStatSetWithinPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatSetWithinPlaceRequest.ProtoReflect.Descriptor instead.
+func (*GetStatSetWithinPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetStatSetWithinPlaceRequest) GetParentPlace() string {
+	if x != nil {
+		return x.ParentPlace
+	}
+	return ""
+}
+
+func (x *GetStatSetWithinPlaceRequest) GetChildType() string {
+	if x != nil {
+		return x.ChildType
+	}
+	return ""
+}
+
+func (x *GetStatSetWithinPlaceRequest) GetStatVars() []string {
+	if x != nil {
+		return x.StatVars
+	}
+	return nil
+}
+
+func (x *GetStatSetWithinPlaceRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetStatSetWithinPlaceRequest) GetDenominator() string {
+	if x != nil {
+		return x.Denominator
+	}
+	return ""
+}
+
+func (x *GetStatSetWithinPlaceRequest) GetPreferredImports() []string {
+	if x != nil {
+		return x.PreferredImports
+	}
+	return nil
+}
+
+func (x *GetStatSetWithinPlaceRequest) GetExcludedImports() []string {
+	if x != nil {
+		return x.ExcludedImports
+	}
+	return nil
+}
+
+func (x *GetStatSetWithinPlaceRequest) GetPlaceSelector() *PlaceSelector {
+	if x != nil {
+		return x.PlaceSelector
+	}
+	return nil
+}
+
+type GetStatDistributionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Parent place dcid.
+	ParentPlace string `protobuf:"bytes,1,opt,name=parent_place,json=parentPlace,proto3" json:"parent_place,omitempty"`
+	// Child place type.
+	ChildType string `protobuf:"bytes,2,opt,name=child_type,json=childType,proto3" json:"child_type,omitempty"`
+	// Dcid of the stat vars.
+	StatVars []string `protobuf:"bytes,3,rep,name=stat_vars,json=statVars,proto3" json:"stat_vars,omitempty"`
+	// (Optional) Date for the stat in ISO format. If not given, the latest value
+	// of each place is used.
+	Date string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// (Optional) Number of equal width histogram bins. Defaults to 10.
+	NumBins int32 `protobuf:"varint,5,opt,name=num_bins,json=numBins,proto3" json:"num_bins,omitempty"`
+	// (Optional) Quantiles in [0, 1] to compute. Defaults to 0.25, 0.5 and 0.75.
+	Quantiles []float64 `protobuf:"fixed64,6,rep,packed,name=quantiles,proto3" json:"quantiles,omitempty"`
+	// (Optional) Import names preferred over the default source ranking, from the
+	// most preferred.
+	PreferredImports []string `protobuf:"bytes,7,rep,name=preferred_imports,json=preferredImports,proto3" json:"preferred_imports,omitempty"`
+	// (Optional) Import names excluded from the sources.
+	ExcludedImports []string `protobuf:"bytes,8,rep,name=excluded_imports,json=excludedImports,proto3" json:"excluded_imports,omitempty"`
+}
+
+func (x *GetStatDistributionRequest) Reset() {
+	*x = GetStatDistributionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatDistributionRequest) ProtoMessage() {}
+
+func (x *GetStatDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatDistributionRequest.ProtoReflect.Descriptor instead.
+func (*GetStatDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetStatDistributionRequest) GetParentPlace() string {
+	if x != nil {
+		return x.ParentPlace
+	}
+	return ""
+}
+
+func (x *GetStatDistributionRequest) GetChildType() string {
+	if x != nil {
+		return x.ChildType
+	}
+	return ""
+}
+
+func (x *GetStatDistributionRequest) GetStatVars() []string {
+	if x != nil {
+		return x.StatVars
+	}
+	return nil
+}
+
+func (x *GetStatDistributionRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetStatDistributionRequest) GetNumBins() int32 {
+	if x != nil {
+		return x.NumBins
+	}
+	return 0
+}
+
+func (x *GetStatDistributionRequest) GetQuantiles() []float64 {
+	if x != nil {
+		return x.Quantiles
+	}
+	return nil
+}
+
+func (x *GetStatDistributionRequest) GetPreferredImports() []string {
+	if x != nil {
+		return x.PreferredImports
+	}
+	return nil
+}
+
+func (x *GetStatDistributionRequest) GetExcludedImports() []string {
+	if x != nil {
+		return x.ExcludedImports
+	}
+	return nil
+}
+
+type StatDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the metadata of the source. All the values come from this source.
+	MetaHash uint32 `protobuf:"varint,1,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+	// Number of places with a value.
+	Count  int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Min    float64 `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max    float64 `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Mean   float64 `protobuf:"fixed64,5,opt,name=mean,proto3" json:"mean,omitempty"`
+	Median float64 `protobuf:"fixed64,6,opt,name=median,proto3" json:"median,omitempty"`
+	// Population standard deviation.
+	Stddev float64 `protobuf:"fixed64,7,opt,name=stddev,proto3" json:"stddev,omitempty"`
+	// Quantiles by linear interpolation between the closest ranks.
+	Quantiles []*StatDistribution_Quantile `protobuf:"bytes,8,rep,name=quantiles,proto3" json:"quantiles,omitempty"`
+	Bins      []*StatDistribution_Bin      `protobuf:"bytes,9,rep,name=bins,proto3" json:"bins,omitempty"`
+	// Places with the min value, sorted by dcid.
+	MinPlaces []string `protobuf:"bytes,10,rep,name=min_places,json=minPlaces,proto3" json:"min_places,omitempty"`
+	// Places with the max value, sorted by dcid.
+	MaxPlaces []string `protobuf:"bytes,11,rep,name=max_places,json=maxPlaces,proto3" json:"max_places,omitempty"`
+}
+
+func (x *StatDistribution) Reset() {
+	*x = StatDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatSetWithinPlaceRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StatDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatDistribution) ProtoMessage() {}
+
+func (x *StatDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatDistribution.ProtoReflect.Descriptor instead.
+func (*StatDistribution) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StatDistribution) GetMetaHash() uint32 {
+	if x != nil {
+		return x.MetaHash
+	}
+	return 0
+}
+
+func (x *StatDistribution) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StatDistribution) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *StatDistribution) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *StatDistribution) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *StatDistribution) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *StatDistribution) GetStddev() float64 {
+	if x != nil {
+		return x.Stddev
+	}
+	return 0
+}
+
+func (x *StatDistribution) GetQuantiles() []*StatDistribution_Quantile {
+	if x != nil {
+		return x.Quantiles
+	}
+	return nil
+}
+
+func (x *StatDistribution) GetBins() []*StatDistribution_Bin {
+	if x != nil {
+		return x.Bins
+	}
+	return nil
+}
+
+func (x *StatDistribution) GetMinPlaces() []string {
+	if x != nil {
+		return x.MinPlaces
+	}
+	return nil
+}
+
+func (x *StatDistribution) GetMaxPlaces() []string {
+	if x != nil {
+		return x.MaxPlaces
+	}
+	return nil
+}
+
+type GetStatDistributionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keyed by statVar. Stat vars without data are not included.
+	Data map[string]*StatDistribution `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Keyed by metadata hash.
+	Metadata map[uint32]*StatMetadata `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetStatDistributionResponse) Reset() {
+	*x = GetStatDistributionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatDistributionResponse) ProtoMessage() {}
+
+func (x *GetStatDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatDistributionResponse.ProtoReflect.Descriptor instead.
+func (*GetStatDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetStatDistributionResponse) GetData() map[string]*StatDistribution {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetStatDistributionResponse) GetMetadata() map[uint32]*StatMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetStatCorrelationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Parent place dcid.
+	ParentPlace string `protobuf:"bytes,1,opt,name=parent_place,json=parentPlace,proto3" json:"parent_place,omitempty"`
+	// Child place type.
+	ChildType string `protobuf:"bytes,2,opt,name=child_type,json=childType,proto3" json:"child_type,omitempty"`
+	// Dcid of the stat var of the x axis.
+	StatVarX string `protobuf:"bytes,3,opt,name=stat_var_x,json=statVarX,proto3" json:"stat_var_x,omitempty"`
+	// Dcid of the stat var of the y axis.
+	StatVarY string `protobuf:"bytes,4,opt,name=stat_var_y,json=statVarY,proto3" json:"stat_var_y,omitempty"`
+	// (Optional) Date for the stats in ISO format. If not given, the latest
+	// values of each place are used and aligned by date_policy.
+	Date string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	// (Optional) How the latest values of a place are aligned, one of "latest"
+	// (the latest value of each stat var), "same_year" (both values in the same
+	// year) and "same_date". Places without aligned values are dropped.
+	// Defaults to "latest". Ignored when date is set.
+	DatePolicy string `protobuf:"bytes,6,opt,name=date_policy,json=datePolicy,proto3" json:"date_policy,omitempty"`
+	// (Optional) Import names preferred over the default source ranking, from the
+	// most preferred.
+	PreferredImports []string `protobuf:"bytes,7,rep,name=preferred_imports,json=preferredImports,proto3" json:"preferred_imports,omitempty"`
+	// (Optional) Import names excluded from the sources.
+	ExcludedImports []string `protobuf:"bytes,8,rep,name=excluded_imports,json=excludedImports,proto3" json:"excluded_imports,omitempty"`
+}
+
+func (x *GetStatCorrelationRequest) Reset() {
+	*x = GetStatCorrelationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatCorrelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatCorrelationRequest) ProtoMessage() {}
+
+func (x *GetStatCorrelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatCorrelationRequest.ProtoReflect.Descriptor instead.
+func (*GetStatCorrelationRequest) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStatCorrelationRequest) GetParentPlace() string {
+	if x != nil {
+		return x.ParentPlace
+	}
+	return ""
+}
+
+func (x *GetStatCorrelationRequest) GetChildType() string {
+	if x != nil {
+		return x.ChildType
+	}
+	return ""
+}
+
+func (x *GetStatCorrelationRequest) GetStatVarX() string {
+	if x != nil {
+		return x.StatVarX
+	}
+	return ""
+}
+
+func (x *GetStatCorrelationRequest) GetStatVarY() string {
+	if x != nil {
+		return x.StatVarY
+	}
+	return ""
+}
+
+func (x *GetStatCorrelationRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetStatCorrelationRequest) GetDatePolicy() string {
+	if x != nil {
+		return x.DatePolicy
+	}
+	return ""
+}
+
+func (x *GetStatCorrelationRequest) GetPreferredImports() []string {
+	if x != nil {
+		return x.PreferredImports
+	}
+	return nil
+}
+
+func (x *GetStatCorrelationRequest) GetExcludedImports() []string {
+	if x != nil {
+		return x.ExcludedImports
+	}
+	return nil
+}
+
+type ScatterPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Place string  `protobuf:"bytes,1,opt,name=place,proto3" json:"place,omitempty"`
+	X     float64 `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y     float64 `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	XDate string  `protobuf:"bytes,4,opt,name=x_date,json=xDate,proto3" json:"x_date,omitempty"`
+	YDate string  `protobuf:"bytes,5,opt,name=y_date,json=yDate,proto3" json:"y_date,omitempty"`
+}
+
+func (x *ScatterPoint) Reset() {
+	*x = ScatterPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScatterPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScatterPoint) ProtoMessage() {}
+
+func (x *ScatterPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScatterPoint.ProtoReflect.Descriptor instead.
+func (*ScatterPoint) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ScatterPoint) GetPlace() string {
+	if x != nil {
+		return x.Place
+	}
+	return ""
+}
+
+func (x *ScatterPoint) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ScatterPoint) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ScatterPoint) GetXDate() string {
+	if x != nil {
+		return x.XDate
+	}
+	return ""
+}
+
+func (x *ScatterPoint) GetYDate() string {
+	if x != nil {
+		return x.YDate
+	}
+	return ""
+}
+
+type GetStatCorrelationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of aligned places.
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Pearson correlation coefficient. Zero when either stat var has no
+	// variation.
+	Pearson float64 `protobuf:"fixed64,2,opt,name=pearson,proto3" json:"pearson,omitempty"`
+	// Spearman rank correlation coefficient, with tied values sharing their
+	// average rank.
+	Spearman float64 `protobuf:"fixed64,3,opt,name=spearman,proto3" json:"spearman,omitempty"`
+	// Least squares fit of y = slope * x + intercept.
+	Slope     float64 `protobuf:"fixed64,4,opt,name=slope,proto3" json:"slope,omitempty"`
+	Intercept float64 `protobuf:"fixed64,5,opt,name=intercept,proto3" json:"intercept,omitempty"`
+	RSquared  float64 `protobuf:"fixed64,6,opt,name=r_squared,json=rSquared,proto3" json:"r_squared,omitempty"`
+	// Aligned points, sorted by place dcid.
+	Points    []*ScatterPoint `protobuf:"bytes,7,rep,name=points,proto3" json:"points,omitempty"`
+	XMetaHash uint32          `protobuf:"varint,8,opt,name=x_meta_hash,json=xMetaHash,proto3" json:"x_meta_hash,omitempty"`
+	YMetaHash uint32          `protobuf:"varint,9,opt,name=y_meta_hash,json=yMetaHash,proto3" json:"y_meta_hash,omitempty"`
+	// Keyed by metadata hash.
+	Metadata map[uint32]*StatMetadata `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetStatCorrelationResponse) Reset() {
+	*x = GetStatCorrelationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatCorrelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatCorrelationResponse) ProtoMessage() {}
+
+func (x *GetStatCorrelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatCorrelationResponse.ProtoReflect.Descriptor instead.
+func (*GetStatCorrelationResponse) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetStatCorrelationResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetStatCorrelationResponse) GetPearson() float64 {
+	if x != nil {
+		return x.Pearson
+	}
+	return 0
+}
+
+func (x *GetStatCorrelationResponse) GetSpearman() float64 {
+	if x != nil {
+		return x.Spearman
+	}
+	return 0
+}
+
+func (x *GetStatCorrelationResponse) GetSlope() float64 {
+	if x != nil {
+		return x.Slope
+	}
+	return 0
+}
+
+func (x *GetStatCorrelationResponse) GetIntercept() float64 {
+	if x != nil {
+		return x.Intercept
+	}
+	return 0
+}
+
+func (x *GetStatCorrelationResponse) GetRSquared() float64 {
+	if x != nil {
+		return x.RSquared
+	}
+	return 0
+}
+
+func (x *GetStatCorrelationResponse) GetPoints() []*ScatterPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetStatCorrelationResponse) GetXMetaHash() uint32 {
+	if x != nil {
+		return x.XMetaHash
+	}
+	return 0
+}
+
+func (x *GetStatCorrelationResponse) GetYMetaHash() uint32 {
+	if x != nil {
+		return x.YMetaHash
+	}
+	return 0
+}
+
+func (x *GetStatCorrelationResponse) GetMetadata() map[uint32]*StatMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetStatSetSeriesWithinPlaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Parent place dcid.
+	ParentPlace string `protobuf:"bytes,1,opt,name=parent_place,json=parentPlace,proto3" json:"parent_place,omitempty"`
+	// Child place type.
+	ChildType string `protobuf:"bytes,2,opt,name=child_type,json=childType,proto3" json:"child_type,omitempty"`
+	// Stat Var dcids.
+	StatVars []string `protobuf:"bytes,3,rep,name=stat_vars,json=statVars,proto3" json:"stat_vars,omitempty"`
+	// (Optional) Start date of the observations, inclusive, in ISO 8601 format.
+	StartDate string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// (Optional) End date of the observations, inclusive, in ISO 8601 format.
+	// A coarser end date includes its sub-periods, like "2020" includes
+	// "2020-12-31".
+	EndDate string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// (Optional) Date granularity of the observations, one of "year", "month"
+	// and "day". Observations of other granularity are dropped.
+	Granularity string `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	// (Optional) Resample the observations to a coarser granularity, one of
+	// "year" and "month". Observations finer than the target are aggregated,
+	// coarser ones are dropped.
+	Resample string `protobuf:"bytes,7,opt,name=resample,proto3" json:"resample,omitempty"`
+	// (Optional) Aggregation method of resampling, one of "sum", "mean", "last",
+	// "min" and "max". Defaults to the method implied by the stat var statType,
+	// or "last".
+	// With "sum", periods missing some of their observations are dropped.
+	Aggregation string `protobuf:"bytes,8,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// (Optional) Place set to use instead of parent_place and child_type.
+	PlaceSelector *PlaceSelector `protobuf:"bytes,9,opt,name=place_selector,json=placeSelector,proto3" json:"place_selector,omitempty"`
+	// (Optional) The options below are the same as in GetStatSetSeriesRequest.
+	ImportName               string   `protobuf:"bytes,10,opt,name=import_name,json=importName,proto3" json:"import_name,omitempty"`
+	Denominator              string   `protobuf:"bytes,11,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	Fill                     string   `protobuf:"bytes,12,opt,name=fill,proto3" json:"fill,omitempty"`
+	Transform                string   `protobuf:"bytes,13,opt,name=transform,proto3" json:"transform,omitempty"`
+	TransformPeriods         int32    `protobuf:"varint,14,opt,name=transform_periods,json=transformPeriods,proto3" json:"transform_periods,omitempty"`
+	Stitch                   bool     `protobuf:"varint,15,opt,name=stitch,proto3" json:"stitch,omitempty"`
+	AllSources               bool     `protobuf:"varint,16,opt,name=all_sources,json=allSources,proto3" json:"all_sources,omitempty"`
+	MeasurementMethod        string   `protobuf:"bytes,17,opt,name=measurement_method,json=measurementMethod,proto3" json:"measurement_method,omitempty"`
+	ObservationPeriod        string   `protobuf:"bytes,18,opt,name=observation_period,json=observationPeriod,proto3" json:"observation_period,omitempty"`
+	Unit                     string   `protobuf:"bytes,19,opt,name=unit,proto3" json:"unit,omitempty"`
+	ScalingFactor            string   `protobuf:"bytes,20,opt,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty"`
+	IsDcAggregate            string   `protobuf:"bytes,21,opt,name=is_dc_aggregate,json=isDcAggregate,proto3" json:"is_dc_aggregate,omitempty"`
+	TargetUnit               string   `protobuf:"bytes,22,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
+	PreferredImports         []string `protobuf:"bytes,23,rep,name=preferred_imports,json=preferredImports,proto3" json:"preferred_imports,omitempty"`
+	ExcludedImports          []string `protobuf:"bytes,24,rep,name=excluded_imports,json=excludedImports,proto3" json:"excluded_imports,omitempty"`
+	StitchMeasurementMethods bool     `protobuf:"varint,25,opt,name=stitch_measurement_methods,json=stitchMeasurementMethods,proto3" json:"stitch_measurement_methods,omitempty"`
+}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) Reset() {
+	*x = GetStatSetSeriesWithinPlaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatSetSeriesWithinPlaceRequest) ProtoMessage() {}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatSetSeriesWithinPlaceRequest.ProtoReflect.Descriptor instead.
+func (*GetStatSetSeriesWithinPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) GetParentPlace() string {
+	if x != nil {
+		return x.ParentPlace
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) GetChildType() string {
+	if x != nil {
+		return x.ChildType
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) GetStatVars() []string {
+	if x != nil {
+		return x.StatVars
+	}
+	return nil
+}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
 }
 
-func (*GetStatSetWithinPlaceRequest) ProtoMessage() {}
+func (x *GetStatSetSeriesWithinPlaceRequest) GetResample() string {
+	if x != nil {
+		return x.Resample
+	}
+	return ""
+}
 
-func (x *GetStatSetWithinPlaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetStatSetSeriesWithinPlaceRequest) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetStatSetWithinPlaceRequest.ProtoReflect.Descriptor instead.
-func (*GetStatSetWithinPlaceRequest) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{26}
+func (x *GetStatSetSeriesWithinPlaceRequest) GetPlaceSelector() *PlaceSelector {
+	if x != nil {
+		return x.PlaceSelector
+	}
+	return nil
 }
 
-func (x *GetStatSetWithinPlaceRequest) GetParentPlace() string {
+func (x *GetStatSetSeriesWithinPlaceRequest) GetImportName() string {
 	if x != nil {
-		return x.ParentPlace
+		return x.ImportName
 	}
 	return ""
 }
 
-func (x *GetStatSetWithinPlaceRequest) GetChildType() string {
+func (x *GetStatSetSeriesWithinPlaceRequest) GetDenominator() string {
 	if x != nil {
-		return x.ChildType
+		return x.Denominator
 	}
 	return ""
 }
 
-func (x *GetStatSetWithinPlaceRequest) GetStatVars() []string {
+func (x *GetStatSetSeriesWithinPlaceRequest) GetFill() string {
 	if x != nil {
-		return x.StatVars
+		return x.Fill
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStatSetWithinPlaceRequest) GetDate() string {
+func (x *GetStatSetSeriesWithinPlaceRequest) GetTransform() string {
 	if x != nil {
-		return x.Date
+		return x.Transform
 	}
 	return ""
 }
 
-type GetStatSetSeriesWithinPlaceRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetStatSetSeriesWithinPlaceRequest) GetTransformPeriods() int32 {
+	if x != nil {
+		return x.TransformPeriods
+	}
+	return 0
+}
 
-	// Parent place dcid.
-	ParentPlace string `protobuf:"bytes,1,opt,name=parent_place,json=parentPlace,proto3" json:"parent_place,omitempty"`
-	// Child place type.
-	ChildType string `protobuf:"bytes,2,opt,name=child_type,json=childType,proto3" json:"child_type,omitempty"`
-	// Stat Var dcids.
-	StatVars []string `protobuf:"bytes,3,rep,name=stat_vars,json=statVars,proto3" json:"stat_vars,omitempty"`
+func (x *GetStatSetSeriesWithinPlaceRequest) GetStitch() bool {
+	if x != nil {
+		return x.Stitch
+	}
+	return false
 }
 
-func (x *GetStatSetSeriesWithinPlaceRequest) Reset() {
-	*x = GetStatSetSeriesWithinPlaceRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetStatSetSeriesWithinPlaceRequest) GetAllSources() bool {
+	if x != nil {
+		return x.AllSources
 	}
+	return false
 }
 
-func (x *GetStatSetSeriesWithinPlaceRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetStatSetSeriesWithinPlaceRequest) GetMeasurementMethod() string {
+	if x != nil {
+		return x.MeasurementMethod
+	}
+	return ""
 }
 
-func (*GetStatSetSeriesWithinPlaceRequest) ProtoMessage() {}
+func (x *GetStatSetSeriesWithinPlaceRequest) GetObservationPeriod() string {
+	if x != nil {
+		return x.ObservationPeriod
+	}
+	return ""
+}
 
-func (x *GetStatSetSeriesWithinPlaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetStatSetSeriesWithinPlaceRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetStatSetSeriesWithinPlaceRequest.ProtoReflect.Descriptor instead.
-func (*GetStatSetSeriesWithinPlaceRequest) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{27}
+func (x *GetStatSetSeriesWithinPlaceRequest) GetScalingFactor() string {
+	if x != nil {
+		return x.ScalingFactor
+	}
+	return ""
 }
 
-func (x *GetStatSetSeriesWithinPlaceRequest) GetParentPlace() string {
+func (x *GetStatSetSeriesWithinPlaceRequest) GetIsDcAggregate() string {
 	if x != nil {
-		return x.ParentPlace
+		return x.IsDcAggregate
 	}
 	return ""
 }
 
-func (x *GetStatSetSeriesWithinPlaceRequest) GetChildType() string {
+func (x *GetStatSetSeriesWithinPlaceRequest) GetTargetUnit() string {
 	if x != nil {
-		return x.ChildType
+		return x.TargetUnit
 	}
 	return ""
 }
 
-func (x *GetStatSetSeriesWithinPlaceRequest) GetStatVars() []string {
+func (x *GetStatSetSeriesWithinPlaceRequest) GetPreferredImports() []string {
 	if x != nil {
-		return x.StatVars
+		return x.PreferredImports
+	}
+	return nil
+}
+
+func (x *GetStatSetSeriesWithinPlaceRequest) GetExcludedImports() []string {
+	if x != nil {
+		return x.ExcludedImports
 	}
 	return nil
 }
 
+func (x *GetStatSetSeriesWithinPlaceRequest) GetStitchMeasurementMethods() bool {
+	if x != nil {
+		return x.StitchMeasurementMethods
+	}
+	return false
+}
+
 type GetStatSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2062,12 +3550,32 @@ type GetStatSetRequest struct {
 	// (Optional) date of the stat.
 	// If not sepcified, the latest stat of a chosen source will be returned.
 	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// (Optional) Dcid of a denominator stat var, like "Count_Person". When set,
+	// each value is divided by the denominator observation of the same place
+	// with the closest date, from the best ranked denominator source after
+	// preferred_imports and excluded_imports.
+	// Can not be set with transform.
+	Denominator string `protobuf:"bytes,4,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	// (Optional) Change between base_date and date, one of "diff", "yoy_pct" (percent
+	// change) and "cagr" (compound annual growth rate in percent). Both values
+	// come from the same source.
+	Transform string `protobuf:"bytes,5,opt,name=transform,proto3" json:"transform,omitempty"`
+	// (Optional) Base date of the transform, required when transform is set.
+	BaseDate string `protobuf:"bytes,6,opt,name=base_date,json=baseDate,proto3" json:"base_date,omitempty"`
+	// (Optional) Convert the values to the unit, applying the scaling factor.
+	// Series whose unit can not be converted are dropped.
+	TargetUnit string `protobuf:"bytes,7,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
+	// (Optional) Import names preferred over the default source ranking, from the
+	// most preferred.
+	PreferredImports []string `protobuf:"bytes,8,rep,name=preferred_imports,json=preferredImports,proto3" json:"preferred_imports,omitempty"`
+	// (Optional) Import names excluded from the sources.
+	ExcludedImports []string `protobuf:"bytes,9,rep,name=excluded_imports,json=excludedImports,proto3" json:"excluded_imports,omitempty"`
 }
 
 func (x *GetStatSetRequest) Reset() {
 	*x = GetStatSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[28]
+		mi := &file_stat_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2080,7 +3588,7 @@ func (x *GetStatSetRequest) String() string {
 func (*GetStatSetRequest) ProtoMessage() {}
 
 func (x *GetStatSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[28]
+	mi := &file_stat_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2093,7 +3601,7 @@ func (x *GetStatSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatSetRequest.ProtoReflect.Descriptor instead.
 func (*GetStatSetRequest) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{28}
+	return file_stat_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetStatSetRequest) GetPlaces() []string {
@@ -2117,6 +3625,48 @@ func (x *GetStatSetRequest) GetDate() string {
 	return ""
 }
 
+func (x *GetStatSetRequest) GetDenominator() string {
+	if x != nil {
+		return x.Denominator
+	}
+	return ""
+}
+
+func (x *GetStatSetRequest) GetTransform() string {
+	if x != nil {
+		return x.Transform
+	}
+	return ""
+}
+
+func (x *GetStatSetRequest) GetBaseDate() string {
+	if x != nil {
+		return x.BaseDate
+	}
+	return ""
+}
+
+func (x *GetStatSetRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
+func (x *GetStatSetRequest) GetPreferredImports() []string {
+	if x != nil {
+		return x.PreferredImports
+	}
+	return nil
+}
+
+func (x *GetStatSetRequest) GetExcludedImports() []string {
+	if x != nil {
+		return x.ExcludedImports
+	}
+	return nil
+}
+
 type GetStatSetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2131,7 +3681,7 @@ type GetStatSetResponse struct {
 func (x *GetStatSetResponse) Reset() {
 	*x = GetStatSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[29]
+		mi := &file_stat_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2144,7 +3694,7 @@ func (x *GetStatSetResponse) String() string {
 func (*GetStatSetResponse) ProtoMessage() {}
 
 func (x *GetStatSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[29]
+	mi := &file_stat_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2157,7 +3707,7 @@ func (x *GetStatSetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatSetResponse.ProtoReflect.Descriptor instead.
 func (*GetStatSetResponse) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{29}
+	return file_stat_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetStatSetResponse) GetData() map[string]*PlacePointStat {
@@ -2188,7 +3738,7 @@ type GetStatSetAllResponse struct {
 func (x *GetStatSetAllResponse) Reset() {
 	*x = GetStatSetAllResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[30]
+		mi := &file_stat_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2201,7 +3751,7 @@ func (x *GetStatSetAllResponse) String() string {
 func (*GetStatSetAllResponse) ProtoMessage() {}
 
 func (x *GetStatSetAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[30]
+	mi := &file_stat_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2214,7 +3764,7 @@ func (x *GetStatSetAllResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatSetAllResponse.ProtoReflect.Descriptor instead.
 func (*GetStatSetAllResponse) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{30}
+	return file_stat_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetStatSetAllResponse) GetData() map[string]*PlacePointStatAll {
@@ -2248,7 +3798,7 @@ type GetPlaceObsRequest struct {
 func (x *GetPlaceObsRequest) Reset() {
 	*x = GetPlaceObsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[31]
+		mi := &file_stat_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2261,7 +3811,7 @@ func (x *GetPlaceObsRequest) String() string {
 func (*GetPlaceObsRequest) ProtoMessage() {}
 
 func (x *GetPlaceObsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[31]
+	mi := &file_stat_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2274,7 +3824,7 @@ func (x *GetPlaceObsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceObsRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceObsRequest) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{31}
+	return file_stat_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetPlaceObsRequest) GetPlaceType() string {
@@ -2311,7 +3861,7 @@ type SVOPlace_Temp struct {
 func (x *SVOPlace_Temp) Reset() {
 	*x = SVOPlace_Temp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[41]
+		mi := &file_stat_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2324,7 +3874,7 @@ func (x *SVOPlace_Temp) String() string {
 func (*SVOPlace_Temp) ProtoMessage() {}
 
 func (x *SVOPlace_Temp) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[41]
+	mi := &file_stat_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2337,51 +3887,171 @@ func (x *SVOPlace_Temp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SVOPlace_Temp.ProtoReflect.Descriptor instead.
 func (*SVOPlace_Temp) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{13, 0}
+	return file_stat_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *SVOPlace_Temp) GetChildPlaces() []string {
 	if x != nil {
-		return x.ChildPlaces
+		return x.ChildPlaces
+	}
+	return nil
+}
+
+func (x *SVOPlace_Temp) GetMids() []string {
+	if x != nil {
+		return x.Mids
+	}
+	return nil
+}
+
+// Not persisted in cache.
+type SVOObservation_Temp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObservationAbout string `protobuf:"bytes,1,opt,name=observation_about,json=observationAbout,proto3" json:"observation_about,omitempty"`
+	VariableMeasured string `protobuf:"bytes,2,opt,name=variable_measured,json=variableMeasured,proto3" json:"variable_measured,omitempty"`
+	ImportName       string `protobuf:"bytes,3,opt,name=import_name,json=importName,proto3" json:"import_name,omitempty"`
+}
+
+func (x *SVOObservation_Temp) Reset() {
+	*x = SVOObservation_Temp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SVOObservation_Temp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SVOObservation_Temp) ProtoMessage() {}
+
+func (x *SVOObservation_Temp) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SVOObservation_Temp.ProtoReflect.Descriptor instead.
+func (*SVOObservation_Temp) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *SVOObservation_Temp) GetObservationAbout() string {
+	if x != nil {
+		return x.ObservationAbout
+	}
+	return ""
+}
+
+func (x *SVOObservation_Temp) GetVariableMeasured() string {
+	if x != nil {
+		return x.VariableMeasured
+	}
+	return ""
+}
+
+func (x *SVOObservation_Temp) GetImportName() string {
+	if x != nil {
+		return x.ImportName
+	}
+	return ""
+}
+
+type StatDistribution_Quantile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quantile float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	Value    float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StatDistribution_Quantile) Reset() {
+	*x = StatDistribution_Quantile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stat_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatDistribution_Quantile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatDistribution_Quantile) ProtoMessage() {}
+
+func (x *StatDistribution_Quantile) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatDistribution_Quantile.ProtoReflect.Descriptor instead.
+func (*StatDistribution_Quantile) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *StatDistribution_Quantile) GetQuantile() float64 {
+	if x != nil {
+		return x.Quantile
 	}
-	return nil
+	return 0
 }
 
-func (x *SVOPlace_Temp) GetMids() []string {
+func (x *StatDistribution_Quantile) GetValue() float64 {
 	if x != nil {
-		return x.Mids
+		return x.Value
 	}
-	return nil
+	return 0
 }
 
-// Not persisted in cache.
-type SVOObservation_Temp struct {
+type StatDistribution_Bin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ObservationAbout string `protobuf:"bytes,1,opt,name=observation_about,json=observationAbout,proto3" json:"observation_about,omitempty"`
-	VariableMeasured string `protobuf:"bytes,2,opt,name=variable_measured,json=variableMeasured,proto3" json:"variable_measured,omitempty"`
-	ImportName       string `protobuf:"bytes,3,opt,name=import_name,json=importName,proto3" json:"import_name,omitempty"`
+	// Lower bound of the bin, inclusive.
+	Low float64 `protobuf:"fixed64,1,opt,name=low,proto3" json:"low,omitempty"`
+	// Upper bound of the bin, exclusive except for the last bin.
+	High  float64 `protobuf:"fixed64,2,opt,name=high,proto3" json:"high,omitempty"`
+	Count int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *SVOObservation_Temp) Reset() {
-	*x = SVOObservation_Temp{}
+func (x *StatDistribution_Bin) Reset() {
+	*x = StatDistribution_Bin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stat_proto_msgTypes[42]
+		mi := &file_stat_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SVOObservation_Temp) String() string {
+func (x *StatDistribution_Bin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SVOObservation_Temp) ProtoMessage() {}
+func (*StatDistribution_Bin) ProtoMessage() {}
 
-func (x *SVOObservation_Temp) ProtoReflect() protoreflect.Message {
-	mi := &file_stat_proto_msgTypes[42]
+func (x *StatDistribution_Bin) ProtoReflect() protoreflect.Message {
+	mi := &file_stat_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2392,423 +4062,784 @@ func (x *SVOObservation_Temp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SVOObservation_Temp.ProtoReflect.Descriptor instead.
-func (*SVOObservation_Temp) Descriptor() ([]byte, []int) {
-	return file_stat_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use StatDistribution_Bin.ProtoReflect.Descriptor instead.
+func (*StatDistribution_Bin) Descriptor() ([]byte, []int) {
+	return file_stat_proto_rawDescGZIP(), []int{30, 1}
 }
 
-func (x *SVOObservation_Temp) GetObservationAbout() string {
+func (x *StatDistribution_Bin) GetLow() float64 {
 	if x != nil {
-		return x.ObservationAbout
+		return x.Low
 	}
-	return ""
+	return 0
 }
 
-func (x *SVOObservation_Temp) GetVariableMeasured() string {
+func (x *StatDistribution_Bin) GetHigh() float64 {
 	if x != nil {
-		return x.VariableMeasured
+		return x.High
 	}
-	return ""
+	return 0
 }
 
-func (x *SVOObservation_Temp) GetImportName() string {
+func (x *StatDistribution_Bin) GetCount() int32 {
 	if x != nil {
-		return x.ImportName
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
 var File_stat_proto protoreflect.FileDescriptor
 
 var file_stat_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x0c, 0x53, 0x74,
-	0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x55,
-	0x72, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e,
-	0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x09,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65,
-	0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb9, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x73, 0x74,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x04, 0x73, 0x74, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61,
-	0x73, 0x68, 0x1a, 0x4f, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x4d, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0xdb, 0x04, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x61,
-	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x64, 0x63, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x44, 0x63, 0x41,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12,
-	0x61, 0x0a, 0x14, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x6f,
-	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x11, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x6f, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x1a, 0x36, 0x0a, 0x08, 0x56, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x54, 0x6f, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x45,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f,
+	0x64, 0x63, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x44, 0x63, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x22, 0x85, 0x02, 0x0a, 0x09, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a,
+	0x15, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x0e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x39, 0x0a, 0x04,
+	0x73, 0x74, 0x61, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x73, 0x74, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x48, 0x61, 0x73, 0x68, 0x1a, 0x4f, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4d, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0xdb, 0x04, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x56, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x64, 0x63, 0x5f, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x44,
+	0x63, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x61, 0x0a, 0x14, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x54, 0x6f, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x11, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x6f, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x1a, 0x36, 0x0a, 0x08, 0x56, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x6f, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xba, 0x03, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x12, 0x35, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x14, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x13, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x36, 0x0a, 0x08, 0x56, 0x61, 0x6c, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xa7, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x76,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x56, 0x61,
-	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x1a, 0x36, 0x0a, 0x08, 0x56, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x09, 0x53,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x4c,
-	0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd4, 0x02, 0x0a,
-	0x0d, 0x4f, 0x62, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x8f, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x34, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x4d, 0x61, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x1a, 0x4c, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x39, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x2b, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a,
+	0x0d, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x38,
 	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x50, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd4, 0x02, 0x0a, 0x0d, 0x4f,
+	0x62, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x62, 0x73, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x64,
+	0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x44, 0x63, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x51, 0x0a, 0x0d, 0x4f, 0x62, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x68,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x68,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x72, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x6f, 0x62, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
 	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x62, 0x73, 0x54, 0x69,
-	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x5f, 0x64, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x44, 0x63, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e,
-	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61,
-	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x51, 0x0a, 0x0d, 0x4f, 0x62, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
-	0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
-	0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x72, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x6f, 0x62, 0x73, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x62, 0x73,
-	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x62,
-	0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x6f,
-	0x62, 0x73, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x4f, 0x62, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
-	0x00, 0x52, 0x0d, 0x6f, 0x62, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x05, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x22, 0xb4, 0x01, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x4b, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61,
-	0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x61,
-	0x74, 0x61, 0x1a, 0x5a, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x62, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa4,
-	0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x4f, 0x62, 0x73, 0x53, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x4f, 0x62, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x1a, 0x53, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x62, 0x73,
-	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61,
-	0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x1a, 0x4c, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xa1, 0x02, 0x0a, 0x08, 0x53, 0x56, 0x4f, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f,
-	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e,
-	0x0a, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x1a, 0x43,
-	0x0a, 0x04, 0x54, 0x65, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x64, 0x73, 0x4a, 0x04, 0x08,
-	0x01, 0x10, 0x02, 0x22, 0x8c, 0x04, 0x0a, 0x0e, 0x53, 0x56, 0x4f, 0x4f, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69,
-	0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23,
-	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x64, 0x62, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x64, 0x62, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56,
-	0x4f, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x6d,
-	0x70, 0x52, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x1a, 0x81, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x6d, 0x70,
-	0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a,
-	0x11, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x76,
-	0x61, 0x6c, 0x22, 0x3e, 0x0a, 0x0d, 0x53, 0x56, 0x4f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x53, 0x56, 0x4f, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x12,
+	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x62, 0x73, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x6f, 0x62, 0x73,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x4f, 0x62, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x0d, 0x6f, 0x62, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x05,
+	0x0a, 0x03, 0x76, 0x61, 0x6c, 0x22, 0xb4, 0x01, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x12, 0x4b, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x1a, 0x5a, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x62, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa4, 0x01, 0x0a,
+	0x10, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x4f, 0x62, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x56, 0x61, 0x72, 0x4f, 0x62, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x53,
+	0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x62, 0x73, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x4c, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa1, 0x02,
+	0x0a, 0x08, 0x53, 0x56, 0x4f, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x65, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x1a, 0x43, 0x0a, 0x04,
+	0x54, 0x65, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x64, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10,
+	0x02, 0x22, 0x8c, 0x04, 0x0a, 0x0e, 0x53, 0x56, 0x4f, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1d, 0x0a, 0x09, 0x64, 0x62, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x64, 0x62, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x34, 0x0a, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x52,
+	0x04, 0x74, 0x65, 0x6d, 0x70, 0x1a, 0x81, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x6d, 0x70, 0x12, 0x2b,
+	0x0a, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x62,
+	0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x76, 0x61, 0x6c,
+	0x22, 0x3e, 0x0a, 0x0d, 0x53, 0x56, 0x4f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x53, 0x56, 0x4f, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x2c, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xbb, 0x06, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x6c, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x69, 0x74, 0x63, 0x68, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12,
 	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x2c, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x6f, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x47,
+	0x6f, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x64, 0x63, 0x5f,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x69, 0x73, 0x44, 0x63, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x73, 0x74, 0x69, 0x74, 0x63,
+	0x68, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x73, 0x74, 0x69,
+	0x74, 0x63, 0x68, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x80, 0x04, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
 	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x4f, 0x0a, 0x09,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4d, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x61, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x4f, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x56, 0x0a, 0x0c, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x03, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76,
+	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x22, 0x2c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xd2, 0x04, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x6c,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x2b,
+	0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x11, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
+	0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xba, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61,
+	0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x22, 0xb9, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x54, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x63, 0x65,
 	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4d,
-	0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf3, 0x01,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73,
-	0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xce, 0x02,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x0d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xa0,
+	0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x42, 0x69, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x09,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x22, 0xe9, 0x03, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64,
+	0x64, 0x65, 0x76, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64, 0x64, 0x65,
+	0x76, 0x12, 0x44, 0x0a, 0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x09, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x69, 0x6e, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x6e, 0x52, 0x04, 0x62, 0x69, 0x6e, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x08,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x41, 0x0a, 0x03, 0x42, 0x69,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe9, 0x02,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x56, 0x0a, 0x09, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x56, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x02, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x58, 0x12, 0x1c, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x5f,
+	0x76, 0x61, 0x72, 0x5f, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x56, 0x61, 0x72, 0x59, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x22, 0x6e, 0x0a, 0x0c, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x79,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x79, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0xd7, 0x03, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x43, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x61, 0x72, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x61, 0x72, 0x73, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x61, 0x72, 0x6d, 0x61, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x70, 0x65, 0x61, 0x72, 0x6d, 0x61, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65, 0x70, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65, 0x70,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x5f, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65, 0x64, 0x12, 0x31,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x63, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1e, 0x0a, 0x0b, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x51, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x56, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb3, 0x07, 0x0a,
+	0x22, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61,
+	0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0e,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x0d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x69, 0x74, 0x63, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x73, 0x74, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x61, 0x6c, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65,
 	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
 	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73,
 	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e,
-	0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x14,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0xe0, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x6d,
+	0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x64, 0x63, 0x5f, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x73,
+	0x44, 0x63, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x18, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x73, 0x74, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x6d,
 	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a,
-	0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
-	0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x64, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x73, 0x74, 0x69, 0x74, 0x63, 0x68,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xcc, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x54, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x48, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x1a, 0x54, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
-	0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x22,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
-	0x73, 0x22, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22,
-	0xcc, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x40, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
 	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x1a, 0x54, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x1a, 0x57, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
 	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8,
-	0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x57, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x56, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x62, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09, 0x5a,
-	0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x62, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x62, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76,
+	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2823,7 +4854,7 @@ func file_stat_proto_rawDescGZIP() []byte {
 	return file_stat_proto_rawDescData
 }
 
-var file_stat_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_stat_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_stat_proto_goTypes = []interface{}{
 	(*StatMetadata)(nil),                       // 0: datacommons.StatMetadata
 	(*PointStat)(nil),                          // 1: datacommons.PointStat
@@ -2832,94 +4863,132 @@ var file_stat_proto_goTypes = []interface{}{
 	(*SourceSeries)(nil),                       // 4: datacommons.SourceSeries
 	(*Series)(nil),                             // 5: datacommons.Series
 	(*SeriesMap)(nil),                          // 6: datacommons.SeriesMap
-	(*ObsTimeSeries)(nil),                      // 7: datacommons.ObsTimeSeries
-	(*ObsCollection)(nil),                      // 8: datacommons.ObsCollection
-	(*ChartStore)(nil),                         // 9: datacommons.ChartStore
-	(*PlaceStat)(nil),                          // 10: datacommons.PlaceStat
-	(*StatVarObsSeries)(nil),                   // 11: datacommons.StatVarObsSeries
-	(*StatVarSeries)(nil),                      // 12: datacommons.StatVarSeries
-	(*SVOPlace)(nil),                           // 13: datacommons.SVOPlace
-	(*SVOObservation)(nil),                     // 14: datacommons.SVOObservation
-	(*SVOCollection)(nil),                      // 15: datacommons.SVOCollection
-	(*GetStatsRequest)(nil),                    // 16: datacommons.GetStatsRequest
-	(*GetStatsResponse)(nil),                   // 17: datacommons.GetStatsResponse
-	(*GetStatSetSeriesRequest)(nil),            // 18: datacommons.GetStatSetSeriesRequest
-	(*GetStatSetSeriesResponse)(nil),           // 19: datacommons.GetStatSetSeriesResponse
-	(*GetStatValueRequest)(nil),                // 20: datacommons.GetStatValueRequest
-	(*GetStatValueResponse)(nil),               // 21: datacommons.GetStatValueResponse
-	(*GetStatSeriesRequest)(nil),               // 22: datacommons.GetStatSeriesRequest
-	(*GetStatSeriesResponse)(nil),              // 23: datacommons.GetStatSeriesResponse
-	(*GetStatAllRequest)(nil),                  // 24: datacommons.GetStatAllRequest
-	(*GetStatAllResponse)(nil),                 // 25: datacommons.GetStatAllResponse
-	(*GetStatSetWithinPlaceRequest)(nil),       // 26: datacommons.GetStatSetWithinPlaceRequest
-	(*GetStatSetSeriesWithinPlaceRequest)(nil), // 27: datacommons.GetStatSetSeriesWithinPlaceRequest
-	(*GetStatSetRequest)(nil),                  // 28: datacommons.GetStatSetRequest
-	(*GetStatSetResponse)(nil),                 // 29: datacommons.GetStatSetResponse
-	(*GetStatSetAllResponse)(nil),              // 30: datacommons.GetStatSetAllResponse
-	(*GetPlaceObsRequest)(nil),                 // 31: datacommons.GetPlaceObsRequest
-	nil,                                        // 32: datacommons.PlacePointStat.StatEntry
-	nil,                                        // 33: datacommons.SourceSeries.ValEntry
-	nil,                                        // 34: datacommons.SourceSeries.PlaceToLatestDateEntry
-	nil,                                        // 35: datacommons.Series.ValEntry
-	nil,                                        // 36: datacommons.SeriesMap.DataEntry
-	nil,                                        // 37: datacommons.ObsTimeSeries.DataEntry
-	nil,                                        // 38: datacommons.PlaceStat.StatVarDataEntry
-	nil,                                        // 39: datacommons.StatVarObsSeries.DataEntry
-	nil,                                        // 40: datacommons.StatVarSeries.DataEntry
-	(*SVOPlace_Temp)(nil),                      // 41: datacommons.SVOPlace.Temp
-	(*SVOObservation_Temp)(nil),                // 42: datacommons.SVOObservation.Temp
-	nil,                                        // 43: datacommons.GetStatSetSeriesResponse.DataEntry
-	nil,                                        // 44: datacommons.GetStatSeriesResponse.SeriesEntry
-	nil,                                        // 45: datacommons.GetStatAllResponse.PlaceDataEntry
-	nil,                                        // 46: datacommons.GetStatSetResponse.DataEntry
-	nil,                                        // 47: datacommons.GetStatSetResponse.MetadataEntry
-	nil,                                        // 48: datacommons.GetStatSetAllResponse.DataEntry
-	nil,                                        // 49: datacommons.GetStatSetAllResponse.MetadataEntry
+	(*SeriesList)(nil),                         // 7: datacommons.SeriesList
+	(*SeriesListMap)(nil),                      // 8: datacommons.SeriesListMap
+	(*ObsTimeSeries)(nil),                      // 9: datacommons.ObsTimeSeries
+	(*ObsCollection)(nil),                      // 10: datacommons.ObsCollection
+	(*ChartStore)(nil),                         // 11: datacommons.ChartStore
+	(*PlaceStat)(nil),                          // 12: datacommons.PlaceStat
+	(*StatVarObsSeries)(nil),                   // 13: datacommons.StatVarObsSeries
+	(*StatVarSeries)(nil),                      // 14: datacommons.StatVarSeries
+	(*SVOPlace)(nil),                           // 15: datacommons.SVOPlace
+	(*SVOObservation)(nil),                     // 16: datacommons.SVOObservation
+	(*SVOCollection)(nil),                      // 17: datacommons.SVOCollection
+	(*GetStatsRequest)(nil),                    // 18: datacommons.GetStatsRequest
+	(*GetStatsResponse)(nil),                   // 19: datacommons.GetStatsResponse
+	(*GetStatSetSeriesRequest)(nil),            // 20: datacommons.GetStatSetSeriesRequest
+	(*GetStatSetSeriesResponse)(nil),           // 21: datacommons.GetStatSetSeriesResponse
+	(*GetStatValueRequest)(nil),                // 22: datacommons.GetStatValueRequest
+	(*GetStatValueResponse)(nil),               // 23: datacommons.GetStatValueResponse
+	(*GetStatSeriesRequest)(nil),               // 24: datacommons.GetStatSeriesRequest
+	(*GetStatSeriesResponse)(nil),              // 25: datacommons.GetStatSeriesResponse
+	(*GetStatAllRequest)(nil),                  // 26: datacommons.GetStatAllRequest
+	(*GetStatAllResponse)(nil),                 // 27: datacommons.GetStatAllResponse
+	(*GetStatSetWithinPlaceRequest)(nil),       // 28: datacommons.GetStatSetWithinPlaceRequest
+	(*GetStatDistributionRequest)(nil),         // 29: datacommons.GetStatDistributionRequest
+	(*StatDistribution)(nil),                   // 30: datacommons.StatDistribution
+	(*GetStatDistributionResponse)(nil),        // 31: datacommons.GetStatDistributionResponse
+	(*GetStatCorrelationRequest)(nil),          // 32: datacommons.GetStatCorrelationRequest
+	(*ScatterPoint)(nil),                       // 33: datacommons.ScatterPoint
+	(*GetStatCorrelationResponse)(nil),         // 34: datacommons.GetStatCorrelationResponse
+	(*GetStatSetSeriesWithinPlaceRequest)(nil), // 35: datacommons.GetStatSetSeriesWithinPlaceRequest
+	(*GetStatSetRequest)(nil),                  // 36: datacommons.GetStatSetRequest
+	(*GetStatSetResponse)(nil),                 // 37: datacommons.GetStatSetResponse
+	(*GetStatSetAllResponse)(nil),              // 38: datacommons.GetStatSetAllResponse
+	(*GetPlaceObsRequest)(nil),                 // 39: datacommons.GetPlaceObsRequest
+	nil,                                        // 40: datacommons.PlacePointStat.StatEntry
+	nil,                                        // 41: datacommons.SourceSeries.ValEntry
+	nil,                                        // 42: datacommons.SourceSeries.PlaceToLatestDateEntry
+	nil,                                        // 43: datacommons.Series.ValEntry
+	nil,                                        // 44: datacommons.Series.MetaHashesEntry
+	nil,                                        // 45: datacommons.SeriesMap.DataEntry
+	nil,                                        // 46: datacommons.SeriesListMap.DataEntry
+	nil,                                        // 47: datacommons.ObsTimeSeries.DataEntry
+	nil,                                        // 48: datacommons.PlaceStat.StatVarDataEntry
+	nil,                                        // 49: datacommons.StatVarObsSeries.DataEntry
+	nil,                                        // 50: datacommons.StatVarSeries.DataEntry
+	(*SVOPlace_Temp)(nil),                      // 51: datacommons.SVOPlace.Temp
+	(*SVOObservation_Temp)(nil),                // 52: datacommons.SVOObservation.Temp
+	nil,                                        // 53: datacommons.GetStatSetSeriesResponse.DataEntry
+	nil,                                        // 54: datacommons.GetStatSetSeriesResponse.MetadataEntry
+	nil,                                        // 55: datacommons.GetStatSetSeriesResponse.AllDataEntry
+	nil,                                        // 56: datacommons.GetStatSeriesResponse.SeriesEntry
+	nil,                                        // 57: datacommons.GetStatAllResponse.PlaceDataEntry
+	(*StatDistribution_Quantile)(nil),          // 58: datacommons.StatDistribution.Quantile
+	(*StatDistribution_Bin)(nil),               // 59: datacommons.StatDistribution.Bin
+	nil,                                        // 60: datacommons.GetStatDistributionResponse.DataEntry
+	nil,                                        // 61: datacommons.GetStatDistributionResponse.MetadataEntry
+	nil,                                        // 62: datacommons.GetStatCorrelationResponse.MetadataEntry
+	nil,                                        // 63: datacommons.GetStatSetResponse.DataEntry
+	nil,                                        // 64: datacommons.GetStatSetResponse.MetadataEntry
+	nil,                                        // 65: datacommons.GetStatSetAllResponse.DataEntry
+	nil,                                        // 66: datacommons.GetStatSetAllResponse.MetadataEntry
+	(*PlaceSelector)(nil),                      // 67: datacommons.PlaceSelector
 }
 var file_stat_proto_depIdxs = []int32{
 	0,  // 0: datacommons.PointStat.metadata:type_name -> datacommons.StatMetadata
-	32, // 1: datacommons.PlacePointStat.stat:type_name -> datacommons.PlacePointStat.StatEntry
+	40, // 1: datacommons.PlacePointStat.stat:type_name -> datacommons.PlacePointStat.StatEntry
 	2,  // 2: datacommons.PlacePointStatAll.stat_list:type_name -> datacommons.PlacePointStat
-	33, // 3: datacommons.SourceSeries.val:type_name -> datacommons.SourceSeries.ValEntry
-	34, // 4: datacommons.SourceSeries.place_to_latest_date:type_name -> datacommons.SourceSeries.PlaceToLatestDateEntry
-	35, // 5: datacommons.Series.val:type_name -> datacommons.Series.ValEntry
+	41, // 3: datacommons.SourceSeries.val:type_name -> datacommons.SourceSeries.ValEntry
+	42, // 4: datacommons.SourceSeries.place_to_latest_date:type_name -> datacommons.SourceSeries.PlaceToLatestDateEntry
+	43, // 5: datacommons.Series.val:type_name -> datacommons.Series.ValEntry
 	0,  // 6: datacommons.Series.metadata:type_name -> datacommons.StatMetadata
-	36, // 7: datacommons.SeriesMap.data:type_name -> datacommons.SeriesMap.DataEntry
-	37, // 8: datacommons.ObsTimeSeries.data:type_name -> datacommons.ObsTimeSeries.DataEntry
-	4,  // 9: datacommons.ObsTimeSeries.source_series:type_name -> datacommons.SourceSeries
-	4,  // 10: datacommons.ObsCollection.source_cohorts:type_name -> datacommons.SourceSeries
-	7,  // 11: datacommons.ChartStore.obs_time_series:type_name -> datacommons.ObsTimeSeries
-	8,  // 12: datacommons.ChartStore.obs_collection:type_name -> datacommons.ObsCollection
-	38, // 13: datacommons.PlaceStat.stat_var_data:type_name -> datacommons.PlaceStat.StatVarDataEntry
-	39, // 14: datacommons.StatVarObsSeries.data:type_name -> datacommons.StatVarObsSeries.DataEntry
-	40, // 15: datacommons.StatVarSeries.data:type_name -> datacommons.StatVarSeries.DataEntry
-	14, // 16: datacommons.SVOPlace.observations:type_name -> datacommons.SVOObservation
-	41, // 17: datacommons.SVOPlace.temp:type_name -> datacommons.SVOPlace.Temp
-	42, // 18: datacommons.SVOObservation.temp:type_name -> datacommons.SVOObservation.Temp
-	13, // 19: datacommons.SVOCollection.places:type_name -> datacommons.SVOPlace
-	43, // 20: datacommons.GetStatSetSeriesResponse.data:type_name -> datacommons.GetStatSetSeriesResponse.DataEntry
-	44, // 21: datacommons.GetStatSeriesResponse.series:type_name -> datacommons.GetStatSeriesResponse.SeriesEntry
-	45, // 22: datacommons.GetStatAllResponse.place_data:type_name -> datacommons.GetStatAllResponse.PlaceDataEntry
-	46, // 23: datacommons.GetStatSetResponse.data:type_name -> datacommons.GetStatSetResponse.DataEntry
-	47, // 24: datacommons.GetStatSetResponse.metadata:type_name -> datacommons.GetStatSetResponse.MetadataEntry
-	48, // 25: datacommons.GetStatSetAllResponse.data:type_name -> datacommons.GetStatSetAllResponse.DataEntry
-	49, // 26: datacommons.GetStatSetAllResponse.metadata:type_name -> datacommons.GetStatSetAllResponse.MetadataEntry
-	1,  // 27: datacommons.PlacePointStat.StatEntry.value:type_name -> datacommons.PointStat
-	5,  // 28: datacommons.SeriesMap.DataEntry.value:type_name -> datacommons.Series
-	7,  // 29: datacommons.PlaceStat.StatVarDataEntry.value:type_name -> datacommons.ObsTimeSeries
-	7,  // 30: datacommons.StatVarObsSeries.DataEntry.value:type_name -> datacommons.ObsTimeSeries
-	5,  // 31: datacommons.StatVarSeries.DataEntry.value:type_name -> datacommons.Series
-	6,  // 32: datacommons.GetStatSetSeriesResponse.DataEntry.value:type_name -> datacommons.SeriesMap
-	10, // 33: datacommons.GetStatAllResponse.PlaceDataEntry.value:type_name -> datacommons.PlaceStat
-	2,  // 34: datacommons.GetStatSetResponse.DataEntry.value:type_name -> datacommons.PlacePointStat
-	0,  // 35: datacommons.GetStatSetResponse.MetadataEntry.value:type_name -> datacommons.StatMetadata
-	3,  // 36: datacommons.GetStatSetAllResponse.DataEntry.value:type_name -> datacommons.PlacePointStatAll
-	0,  // 37: datacommons.GetStatSetAllResponse.MetadataEntry.value:type_name -> datacommons.StatMetadata
-	38, // [38:38] is the sub-list for method output_type
-	38, // [38:38] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+	0,  // 7: datacommons.Series.denominator_metadata:type_name -> datacommons.StatMetadata
+	44, // 8: datacommons.Series.meta_hashes:type_name -> datacommons.Series.MetaHashesEntry
+	45, // 9: datacommons.SeriesMap.data:type_name -> datacommons.SeriesMap.DataEntry
+	5,  // 10: datacommons.SeriesList.series:type_name -> datacommons.Series
+	46, // 11: datacommons.SeriesListMap.data:type_name -> datacommons.SeriesListMap.DataEntry
+	47, // 12: datacommons.ObsTimeSeries.data:type_name -> datacommons.ObsTimeSeries.DataEntry
+	4,  // 13: datacommons.ObsTimeSeries.source_series:type_name -> datacommons.SourceSeries
+	4,  // 14: datacommons.ObsCollection.source_cohorts:type_name -> datacommons.SourceSeries
+	9,  // 15: datacommons.ChartStore.obs_time_series:type_name -> datacommons.ObsTimeSeries
+	10, // 16: datacommons.ChartStore.obs_collection:type_name -> datacommons.ObsCollection
+	48, // 17: datacommons.PlaceStat.stat_var_data:type_name -> datacommons.PlaceStat.StatVarDataEntry
+	49, // 18: datacommons.StatVarObsSeries.data:type_name -> datacommons.StatVarObsSeries.DataEntry
+	50, // 19: datacommons.StatVarSeries.data:type_name -> datacommons.StatVarSeries.DataEntry
+	16, // 20: datacommons.SVOPlace.observations:type_name -> datacommons.SVOObservation
+	51, // 21: datacommons.SVOPlace.temp:type_name -> datacommons.SVOPlace.Temp
+	52, // 22: datacommons.SVOObservation.temp:type_name -> datacommons.SVOObservation.Temp
+	15, // 23: datacommons.SVOCollection.places:type_name -> datacommons.SVOPlace
+	53, // 24: datacommons.GetStatSetSeriesResponse.data:type_name -> datacommons.GetStatSetSeriesResponse.DataEntry
+	54, // 25: datacommons.GetStatSetSeriesResponse.metadata:type_name -> datacommons.GetStatSetSeriesResponse.MetadataEntry
+	55, // 26: datacommons.GetStatSetSeriesResponse.all_data:type_name -> datacommons.GetStatSetSeriesResponse.AllDataEntry
+	56, // 27: datacommons.GetStatSeriesResponse.series:type_name -> datacommons.GetStatSeriesResponse.SeriesEntry
+	57, // 28: datacommons.GetStatAllResponse.place_data:type_name -> datacommons.GetStatAllResponse.PlaceDataEntry
+	67, // 29: datacommons.GetStatSetWithinPlaceRequest.place_selector:type_name -> datacommons.PlaceSelector
+	58, // 30: datacommons.StatDistribution.quantiles:type_name -> datacommons.StatDistribution.Quantile
+	59, // 31: datacommons.StatDistribution.bins:type_name -> datacommons.StatDistribution.Bin
+	60, // 32: datacommons.GetStatDistributionResponse.data:type_name -> datacommons.GetStatDistributionResponse.DataEntry
+	61, // 33: datacommons.GetStatDistributionResponse.metadata:type_name -> datacommons.GetStatDistributionResponse.MetadataEntry
+	33, // 34: datacommons.GetStatCorrelationResponse.points:type_name -> datacommons.ScatterPoint
+	62, // 35: datacommons.GetStatCorrelationResponse.metadata:type_name -> datacommons.GetStatCorrelationResponse.MetadataEntry
+	67, // 36: datacommons.GetStatSetSeriesWithinPlaceRequest.place_selector:type_name -> datacommons.PlaceSelector
+	63, // 37: datacommons.GetStatSetResponse.data:type_name -> datacommons.GetStatSetResponse.DataEntry
+	64, // 38: datacommons.GetStatSetResponse.metadata:type_name -> datacommons.GetStatSetResponse.MetadataEntry
+	65, // 39: datacommons.GetStatSetAllResponse.data:type_name -> datacommons.GetStatSetAllResponse.DataEntry
+	66, // 40: datacommons.GetStatSetAllResponse.metadata:type_name -> datacommons.GetStatSetAllResponse.MetadataEntry
+	1,  // 41: datacommons.PlacePointStat.StatEntry.value:type_name -> datacommons.PointStat
+	5,  // 42: datacommons.SeriesMap.DataEntry.value:type_name -> datacommons.Series
+	7,  // 43: datacommons.SeriesListMap.DataEntry.value:type_name -> datacommons.SeriesList
+	9,  // 44: datacommons.PlaceStat.StatVarDataEntry.value:type_name -> datacommons.ObsTimeSeries
+	9,  // 45: datacommons.StatVarObsSeries.DataEntry.value:type_name -> datacommons.ObsTimeSeries
+	5,  // 46: datacommons.StatVarSeries.DataEntry.value:type_name -> datacommons.Series
+	6,  // 47: datacommons.GetStatSetSeriesResponse.DataEntry.value:type_name -> datacommons.SeriesMap
+	0,  // 48: datacommons.GetStatSetSeriesResponse.MetadataEntry.value:type_name -> datacommons.StatMetadata
+	8,  // 49: datacommons.GetStatSetSeriesResponse.AllDataEntry.value:type_name -> datacommons.SeriesListMap
+	12, // 50: datacommons.GetStatAllResponse.PlaceDataEntry.value:type_name -> datacommons.PlaceStat
+	30, // 51: datacommons.GetStatDistributionResponse.DataEntry.value:type_name -> datacommons.StatDistribution
+	0,  // 52: datacommons.GetStatDistributionResponse.MetadataEntry.value:type_name -> datacommons.StatMetadata
+	0,  // 53: datacommons.GetStatCorrelationResponse.MetadataEntry.value:type_name -> datacommons.StatMetadata
+	2,  // 54: datacommons.GetStatSetResponse.DataEntry.value:type_name -> datacommons.PlacePointStat
+	0,  // 55: datacommons.GetStatSetResponse.MetadataEntry.value:type_name -> datacommons.StatMetadata
+	3,  // 56: datacommons.GetStatSetAllResponse.DataEntry.value:type_name -> datacommons.PlacePointStatAll
+	0,  // 57: datacommons.GetStatSetAllResponse.MetadataEntry.value:type_name -> datacommons.StatMetadata
+	58, // [58:58] is the sub-list for method output_type
+	58, // [58:58] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_stat_proto_init() }
@@ -2927,6 +4996,7 @@ func file_stat_proto_init() {
 	if File_stat_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_stat_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatMetadata); i {
@@ -3013,7 +5083,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObsTimeSeries); i {
+			switch v := v.(*SeriesList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3025,7 +5095,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObsCollection); i {
+			switch v := v.(*SeriesListMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3037,7 +5107,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChartStore); i {
+			switch v := v.(*ObsTimeSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3049,7 +5119,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaceStat); i {
+			switch v := v.(*ObsCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3061,7 +5131,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatVarObsSeries); i {
+			switch v := v.(*ChartStore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3073,7 +5143,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatVarSeries); i {
+			switch v := v.(*PlaceStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3085,7 +5155,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SVOPlace); i {
+			switch v := v.(*StatVarObsSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3097,7 +5167,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SVOObservation); i {
+			switch v := v.(*StatVarSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3109,7 +5179,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SVOCollection); i {
+			switch v := v.(*SVOPlace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3121,7 +5191,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatsRequest); i {
+			switch v := v.(*SVOObservation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3133,7 +5203,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatsResponse); i {
+			switch v := v.(*SVOCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3145,7 +5215,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatSetSeriesRequest); i {
+			switch v := v.(*GetStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3157,7 +5227,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatSetSeriesResponse); i {
+			switch v := v.(*GetStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3169,7 +5239,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatValueRequest); i {
+			switch v := v.(*GetStatSetSeriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3181,7 +5251,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatValueResponse); i {
+			switch v := v.(*GetStatSetSeriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3193,7 +5263,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatSeriesRequest); i {
+			switch v := v.(*GetStatValueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3205,7 +5275,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatSeriesResponse); i {
+			switch v := v.(*GetStatValueResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3217,7 +5287,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatAllRequest); i {
+			switch v := v.(*GetStatSeriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3229,7 +5299,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatAllResponse); i {
+			switch v := v.(*GetStatSeriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3241,7 +5311,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatSetWithinPlaceRequest); i {
+			switch v := v.(*GetStatAllRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3253,7 +5323,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatSetSeriesWithinPlaceRequest); i {
+			switch v := v.(*GetStatAllResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3265,7 +5335,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatSetRequest); i {
+			switch v := v.(*GetStatSetWithinPlaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3277,7 +5347,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatSetResponse); i {
+			switch v := v.(*GetStatDistributionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3289,7 +5359,7 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatSetAllResponse); i {
+			switch v := v.(*StatDistribution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3301,6 +5371,102 @@ func file_stat_proto_init() {
 			}
 		}
 		file_stat_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatDistributionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stat_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatCorrelationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stat_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScatterPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stat_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatCorrelationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stat_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatSetSeriesWithinPlaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stat_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stat_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stat_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatSetAllResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stat_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPlaceObsRequest); i {
 			case 0:
 				return &v.state
@@ -3312,7 +5478,7 @@ func file_stat_proto_init() {
 				return nil
 			}
 		}
-		file_stat_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_stat_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SVOPlace_Temp); i {
 			case 0:
 				return &v.state
@@ -3324,7 +5490,7 @@ func file_stat_proto_init() {
 				return nil
 			}
 		}
-		file_stat_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_stat_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SVOObservation_Temp); i {
 			case 0:
 				return &v.state
@@ -3336,12 +5502,36 @@ func file_stat_proto_init() {
 				return nil
 			}
 		}
+		file_stat_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatDistribution_Quantile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stat_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatDistribution_Bin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_stat_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_stat_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*ChartStore_ObsTimeSeries)(nil),
 		(*ChartStore_ObsCollection)(nil),
 	}
-	file_stat_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_stat_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*SVOObservation_StrValue)(nil),
 		(*SVOObservation_DblValue)(nil),
 	}
@@ -3351,7 +5541,7 @@ func file_stat_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stat_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   50,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
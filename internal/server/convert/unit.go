@@ -0,0 +1,117 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+)
+
+// Unit is a unit that can be converted to the base unit of its dimension.
+// A value in the unit equals Factor * value + Offset in the base unit.
+type Unit struct {
+	Dimension string  `json:"dimension"`
+	Factor    float64 `json:"factor"`
+	Offset    float64 `json:"offset,omitempty"`
+}
+
+// Units is the registry of convertible units, keyed by unit dcid.
+var Units = map[string]*Unit{
+	// Currency, only the multiples of a currency are convertible.
+	"USDollar":          {Dimension: "USDollar", Factor: 1},
+	"ThousandUSDollar":  {Dimension: "USDollar", Factor: 1e3},
+	"MillionUSDollar":   {Dimension: "USDollar", Factor: 1e6},
+	"BillionUSDollar":   {Dimension: "USDollar", Factor: 1e9},
+	"Euro":              {Dimension: "Euro", Factor: 1},
+	"ThousandEuro":      {Dimension: "Euro", Factor: 1e3},
+	"MillionEuro":       {Dimension: "Euro", Factor: 1e6},
+	"Celsius":           {Dimension: "Temperature", Factor: 1},
+	"Fahrenheit":        {Dimension: "Temperature", Factor: 5.0 / 9, Offset: -160.0 / 9},
+	"Kelvin":            {Dimension: "Temperature", Factor: 1, Offset: -273.15},
+	"KilowattHour":      {Dimension: "Energy", Factor: 1},
+	"MegawattHour":      {Dimension: "Energy", Factor: 1e3},
+	"GigawattHour":      {Dimension: "Energy", Factor: 1e6},
+	"Gram":              {Dimension: "Mass", Factor: 1},
+	"Kilogram":          {Dimension: "Mass", Factor: 1e3},
+	"MetricTon":         {Dimension: "Mass", Factor: 1e6},
+	"Meter":             {Dimension: "Length", Factor: 1},
+	"Kilometer":         {Dimension: "Length", Factor: 1e3},
+	"Mile":              {Dimension: "Length", Factor: 1609.344},
+	"SquareMeter":       {Dimension: "Area", Factor: 1},
+	"SquareKilometer":   {Dimension: "Area", Factor: 1e6},
+	"SquareMile":        {Dimension: "Area", Factor: 2589988.110336},
+	"Liter":             {Dimension: "Volume", Factor: 1},
+	"CubicMeter":        {Dimension: "Volume", Factor: 1e3},
+	"MillimeterOfWater": {Dimension: "Precipitation", Factor: 1},
+	"InchOfWater":       {Dimension: "Precipitation", Factor: 25.4},
+}
+
+// LoadUnits replaces the unit registry with the units in a JSON file, which
+// maps unit dcid to its dimension, factor and offset.
+func LoadUnits(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	units, err := ParseUnits(data)
+	if err != nil {
+		return err
+	}
+	Units = units
+	return nil
+}
+
+// ParseUnits parses a unit registry in JSON.
+func ParseUnits(data []byte) (map[string]*Unit, error) {
+	units := map[string]*Unit{}
+	if err := json.Unmarshal(data, &units); err != nil {
+		return nil, err
+	}
+	for name, unit := range units {
+		if unit == nil || unit.Dimension == "" || unit.Factor == 0 {
+			return nil, fmt.Errorf("invalid unit %s: missing dimension or factor", name)
+		}
+	}
+	return units, nil
+}
+
+// ConvertUnit converts a value in unit with scaling factor to the target unit.
+// The scaling factor is the multiplier applied to the measured value, like
+// "100" for a percentage, and is removed by the conversion.
+//
+// Returns false when the unit can not be converted to the target unit.
+func ConvertUnit(value float64, unit, scalingFactor, target string) (float64, bool) {
+	if scalingFactor != "" {
+		factor, err := strconv.ParseFloat(scalingFactor, 64)
+		if err != nil || factor == 0 {
+			return 0, false
+		}
+		value /= factor
+	}
+	if unit == target {
+		return value, true
+	}
+	from, ok := Units[unit]
+	if !ok {
+		return 0, false
+	}
+	to, ok := Units[target]
+	if !ok || to.Dimension != from.Dimension {
+		return 0, false
+	}
+	return (from.Factor*value + from.Offset - to.Offset) / to.Factor, true
+}
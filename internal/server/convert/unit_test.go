@@ -0,0 +1,66 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConvertUnit(t *testing.T) {
+	for _, c := range []struct {
+		value         float64
+		unit          string
+		scalingFactor string
+		target        string
+		want          float64
+		wantOk        bool
+	}{
+		{12, "ThousandUSDollar", "", "USDollar", 12000, true},
+		{12000, "USDollar", "", "MillionUSDollar", 0.012, true},
+		{212, "Fahrenheit", "", "Celsius", 100, true},
+		{0, "Celsius", "", "Kelvin", 273.15, true},
+		{5, "Percent", "100", "Percent", 0.05, true},
+		{2, "GigawattHour", "", "KilowattHour", 2000000, true},
+		{1, "USDollar", "", "Euro", 0, false},
+		{1, "Unknown", "", "USDollar", 0, false},
+		{1, "USDollar", "abc", "USDollar", 0, false},
+	} {
+		got, ok := ConvertUnit(c.value, c.unit, c.scalingFactor, c.target)
+		if ok != c.wantOk {
+			t.Errorf("ConvertUnit(%v, %s, %s) ok = %v, want %v",
+				c.value, c.unit, c.target, ok, c.wantOk)
+			continue
+		}
+		if math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("ConvertUnit(%v, %s, %s) = %v, want %v",
+				c.value, c.unit, c.target, got, c.want)
+		}
+	}
+}
+
+func TestParseUnits(t *testing.T) {
+	units, err := ParseUnits([]byte(
+		`{"Yard": {"dimension": "Length", "factor": 0.9144}, "Meter": {"dimension": "Length", "factor": 1}}`))
+	if err != nil {
+		t.Fatalf("ParseUnits() got error %v", err)
+	}
+	if got := units["Yard"].Factor; got != 0.9144 {
+		t.Errorf("ParseUnits() got Yard factor %v, want 0.9144", got)
+	}
+	if _, err := ParseUnits([]byte(`{"Yard": {"dimension": "Length"}}`)); err == nil {
+		t.Errorf("ParseUnits() should fail on missing factor")
+	}
+}
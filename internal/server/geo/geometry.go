@@ -0,0 +1,186 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import "math"
+
+// contains returns whether the box contains the point. The box does not cross
+// the antimeridian.
+func (b Box) contains(lng, lat float64) bool {
+	return lat >= b.MinLat && lat <= b.MaxLat && lng >= b.MinLng && lng <= b.MaxLng
+}
+
+// overlaps returns whether two boxes overlap. The box does not cross the
+// antimeridian.
+func (b Box) overlaps(other Box) bool {
+	return b.MinLat <= other.MaxLat && other.MinLat <= b.MaxLat &&
+		b.MinLng <= other.MaxLng && other.MinLng <= b.MaxLng
+}
+
+// inRing returns whether a point is inside a ring, by ray casting.
+func inRing(lng, lat float64, ring [][2]float64) bool {
+	result := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if (yi > lat) != (yj > lat) && lng < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			result = !result
+		}
+	}
+	return result
+}
+
+// inRings returns whether a point is inside an odd number of rings, so holes
+// are outside.
+func inRings(lng, lat float64, rings [][][2]float64) bool {
+	result := false
+	for _, ring := range rings {
+		if inRing(lng, lat, ring) {
+			result = !result
+		}
+	}
+	return result
+}
+
+// ringArea returns the area of a ring by the shoelace formula.
+func ringArea(ring [][2]float64) float64 {
+	area := 0.0
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		area += (ring[j][0] + ring[i][0]) * (ring[j][1] - ring[i][1])
+	}
+	return math.Abs(area) / 2
+}
+
+// boundaryArea returns the area of the rings. Rings inside an odd number of
+// other rings are holes, and are subtracted.
+func boundaryArea(rings [][][2]float64) float64 {
+	area := 0.0
+	for i, ring := range rings {
+		if len(ring) == 0 {
+			continue
+		}
+		inside := 0
+		for j, other := range rings {
+			if i != j && inRing(ring[0][0], ring[0][1], other) {
+				inside++
+			}
+		}
+		if inside%2 == 1 {
+			area -= ringArea(ring)
+		} else {
+			area += ringArea(ring)
+		}
+	}
+	return area
+}
+
+// orientation returns the sign of the cross product of (b - a) and (c - a).
+func orientation(a, b, c [2]float64) int {
+	v := (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+	if v > 0 {
+		return 1
+	}
+	if v < 0 {
+		return -1
+	}
+	return 0
+}
+
+// onSegment returns whether c, collinear with a and b, is on the segment ab.
+func onSegment(a, b, c [2]float64) bool {
+	return c[0] >= min(a[0], b[0]) && c[0] <= max(a[0], b[0]) &&
+		c[1] >= min(a[1], b[1]) && c[1] <= max(a[1], b[1])
+}
+
+func min(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// segmentsIntersect returns whether segments p1p2 and q1q2 intersect.
+func segmentsIntersect(p1, p2, q1, q2 [2]float64) bool {
+	o1 := orientation(p1, p2, q1)
+	o2 := orientation(p1, p2, q2)
+	o3 := orientation(q1, q2, p1)
+	o4 := orientation(q1, q2, p2)
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+	return (o1 == 0 && onSegment(p1, p2, q1)) ||
+		(o2 == 0 && onSegment(p1, p2, q2)) ||
+		(o3 == 0 && onSegment(q1, q2, p1)) ||
+		(o4 == 0 && onSegment(q1, q2, p2))
+}
+
+// ringsIntersectBox returns whether a boundary intersects the box: a vertex of
+// the boundary is in the box, a corner of the box is in the boundary, or their
+// edges cross.
+func ringsIntersectBox(rings [][][2]float64, b Box) bool {
+	corners := [][2]float64{
+		{b.MinLng, b.MinLat}, {b.MaxLng, b.MinLat}, {b.MaxLng, b.MaxLat}, {b.MinLng, b.MaxLat},
+	}
+	for _, c := range corners {
+		if inRings(c[0], c[1], rings) {
+			return true
+		}
+	}
+	for _, ring := range rings {
+		for i := range ring {
+			if b.contains(ring[i][0], ring[i][1]) {
+				return true
+			}
+			a, z := ring[i], ring[(i+1)%len(ring)]
+			for k := range corners {
+				if segmentsIntersect(a, z, corners[k], corners[(k+1)%4]) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// intersects returns whether the boundary of the place, or its location when
+// it has no boundary, intersects the box. The box does not cross the
+// antimeridian.
+func intersects(p *Place, b Box) bool {
+	if len(p.Polygons) == 0 {
+		return b.contains(p.Longitude, p.Latitude)
+	}
+	return p.Bounds.overlaps(b) && ringsIntersectBox(p.Polygons, b)
+}
+
+// ringBounds returns the bounding box of the rings.
+func ringBounds(rings [][][2]float64) Box {
+	b := Box{MinLat: 90, MinLng: 180, MaxLat: -90, MaxLng: -180}
+	for _, ring := range rings {
+		for _, p := range ring {
+			b.MinLng = min(b.MinLng, p[0])
+			b.MaxLng = max(b.MaxLng, p[0])
+			b.MinLat = min(b.MinLat, p[1])
+			b.MaxLat = max(b.MaxLat, p[1])
+		}
+	}
+	return b
+}
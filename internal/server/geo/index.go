@@ -0,0 +1,213 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geo is a spatial index of place locations and boundaries.
+package geo
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// earthRadiusKm is the mean radius of the Earth.
+const earthRadiusKm = 6371.0
+
+// kmPerDegree is the length of a degree of latitude.
+const kmPerDegree = earthRadiusKm * math.Pi / 180
+
+// Box is a bounding box in degrees. MinLng is larger than MaxLng when the box
+// crosses the antimeridian.
+type Box struct {
+	MinLat, MinLng, MaxLat, MaxLng float64
+}
+
+// Place is a place with a location and an optional boundary.
+type Place struct {
+	Dcid      string
+	Types     []string
+	Latitude  float64
+	Longitude float64
+	Name      string
+	// Rings of the boundary, including holes, each is a ring of [longitude,
+	// latitude] points. A point is inside the boundary when it is inside an odd
+	// number of rings.
+	Polygons [][][2]float64
+	// Bounding box of the boundary, or of the location without boundary.
+	Bounds Box
+	// Area of the boundary in square degrees, used to order nested places.
+	Area float64
+}
+
+// HasType returns whether the place is of the type.
+func (p *Place) HasType(placeType string) bool {
+	for _, t := range p.Types {
+		if t == placeType {
+			return true
+		}
+	}
+	return false
+}
+
+// Index is an R-tree index of places, by the bounding box of their boundary or
+// location.
+type Index struct {
+	root *rtreeNode
+}
+
+// NewIndex builds an index of the places.
+func NewIndex(places []*Place) *Index {
+	return &Index{root: newRtree(places)}
+}
+
+// candidates returns the places of the type whose bounding box overlaps a box.
+func (index *Index) candidates(b Box, placeType string) []*Place {
+	seen := map[*Place]bool{}
+	result := []*Place{}
+	for _, box := range splitBox(b) {
+		index.root.search(box, func(p *Place) {
+			if seen[p] || !p.HasType(placeType) {
+				return
+			}
+			seen[p] = true
+			result = append(result, p)
+		})
+	}
+	return result
+}
+
+// Distance computes the great circle distance in km between two points.
+func Distance(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := math.Pi / 180
+	dLat := (lat2 - lat1) * toRad
+	dLng := (lng2 - lng1) * toRad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*toRad)*math.Cos(lat2*toRad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// radiusBox returns the bounding box of a circle.
+func radiusBox(lat, lng, radiusKm float64) Box {
+	dLat := radiusKm / kmPerDegree
+	b := Box{
+		MinLat: math.Max(-90, lat-dLat),
+		MaxLat: math.Min(90, lat+dLat),
+		MinLng: -180,
+		MaxLng: 180,
+	}
+	if b.MinLat > -90 && b.MaxLat < 90 {
+		maxAbsLat := math.Max(math.Abs(b.MinLat), math.Abs(b.MaxLat))
+		dLng := dLat / math.Cos(maxAbsLat*math.Pi/180)
+		if dLng < 180 {
+			b.MinLng = lng - dLng
+			b.MaxLng = lng + dLng
+			if b.MinLng < -180 {
+				b.MinLng += 360
+			}
+			if b.MaxLng > 180 {
+				b.MaxLng -= 360
+			}
+		}
+	}
+	return b
+}
+
+// PlaceDistance is a place with its distance to a point.
+type PlaceDistance struct {
+	Place      *Place
+	DistanceKm float64
+}
+
+// WithinRadius returns the places of the type whose location is within the
+// radius of a point, from the closest.
+func (index *Index) WithinRadius(
+	lat, lng, radiusKm float64, placeType string,
+) []*PlaceDistance {
+	result := []*PlaceDistance{}
+	for _, p := range index.candidates(radiusBox(lat, lng, radiusKm), placeType) {
+		d := Distance(lat, lng, p.Latitude, p.Longitude)
+		if d <= radiusKm {
+			result = append(result, &PlaceDistance{Place: p, DistanceKm: d})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].DistanceKm != result[j].DistanceKm {
+			return result[i].DistanceKm < result[j].DistanceKm
+		}
+		return result[i].Place.Dcid < result[j].Place.Dcid
+	})
+	return result
+}
+
+// IntersectBox returns the places of the type whose boundary, or location
+// without boundary, intersects the box, sorted by dcid.
+func (index *Index) IntersectBox(b Box, placeType string) []*Place {
+	// Boxes crossing the antimeridian are split in two.
+	boxes := splitBox(b)
+	result := []*Place{}
+	for _, p := range index.candidates(b, placeType) {
+		for _, box := range boxes {
+			if intersects(p, box) {
+				result = append(result, p)
+				break
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Dcid < result[j].Dcid
+	})
+	return result
+}
+
+// Containing returns the places whose boundary contains the point, from the
+// smallest.
+func (index *Index) Containing(lat, lng float64) []*Place {
+	result := []*Place{}
+	seen := map[*Place]bool{}
+	index.root.search(Box{MinLat: lat, MinLng: lng, MaxLat: lat, MaxLng: lng}, func(p *Place) {
+		if seen[p] || len(p.Polygons) == 0 {
+			return
+		}
+		seen[p] = true
+		if inRings(lng, lat, p.Polygons) {
+			result = append(result, p)
+		}
+	})
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Area != result[j].Area {
+			return result[i].Area < result[j].Area
+		}
+		return result[i].Dcid < result[j].Dcid
+	})
+	return result
+}
+
+var (
+	indexLock    sync.RWMutex
+	currentIndex = NewIndex(nil)
+)
+
+// SetIndex replaces the index used by the place APIs.
+func SetIndex(index *Index) {
+	indexLock.Lock()
+	defer indexLock.Unlock()
+	currentIndex = index
+}
+
+// CurrentIndex returns the index used by the place APIs.
+func CurrentIndex() *Index {
+	indexLock.RLock()
+	defer indexLock.RUnlock()
+	return currentIndex
+}
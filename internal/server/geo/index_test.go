@@ -0,0 +1,160 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const triples = `# Test places.
+dcid:geoId/06085,typeOf,dcid:County
+geoId/06085,latitude,37.2
+geoId/06085,longitude,-121.7
+geoId/06085,geoJsonCoordinates,"{""type"": ""Polygon"", ""coordinates"": [[[-122.2, 36.9], [-121.2, 36.9], [-121.2, 37.5], [-122.2, 37.5], [-122.2, 36.9]]]}"
+geoId/06081,typeOf,County
+geoId/06081,latitude,37.4
+geoId/06081,longitude,-122.3
+geoId/0667000,typeOf,City
+geoId/0667000,latitude,37.7749
+geoId/0667000,longitude,-122.4194
+wikidataId/Q1,typeOf,City
+wikidataId/Q1,latitude,-17.8
+wikidataId/Q1,longitude,178.4
+wikidataId/Q2,typeOf,City
+wikidataId/Q2,latitude,-16.5
+wikidataId/Q2,longitude,-179.9
+geoId/06,typeOf,State
+`
+
+func TestIndex(t *testing.T) {
+	places, err := ParseTriples(strings.NewReader(triples))
+	if err != nil {
+		t.Fatalf("ParseTriples() got error %v", err)
+	}
+	// geoId/06 has no location.
+	if len(places) != 5 {
+		t.Errorf("ParseTriples() got %d places, want 5", len(places))
+	}
+	index := NewIndex(places)
+
+	for _, c := range []struct {
+		radiusKm float64
+		want     []string
+	}{
+		{50, []string{"geoId/06081"}},
+		{100, []string{"geoId/06081", "geoId/06085"}},
+	} {
+		got := []string{}
+		for _, p := range index.WithinRadius(37.7749, -122.4194, c.radiusKm, "County") {
+			got = append(got, p.Place.Dcid)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("WithinRadius(%v) got diff: %v", c.radiusKm, diff)
+		}
+	}
+
+	for _, c := range []struct {
+		box       Box
+		placeType string
+		want      []string
+	}{
+		// Inside the boundary of geoId/06085.
+		{Box{MinLat: 37.0, MinLng: -121.5, MaxLat: 37.1, MaxLng: -121.4}, "County", []string{"geoId/06085"}},
+		{Box{MinLat: 37.3, MinLng: -122.5, MaxLat: 37.6, MaxLng: -122.1}, "County", []string{"geoId/06081", "geoId/06085"}},
+		{Box{MinLat: 38, MinLng: -121.5, MaxLat: 39, MaxLng: -121.4}, "County", []string{}},
+		// Crossing the antimeridian.
+		{Box{MinLat: -18, MinLng: 178, MaxLat: -16, MaxLng: -179}, "City", []string{"wikidataId/Q1", "wikidataId/Q2"}},
+	} {
+		got := []string{}
+		for _, p := range index.IntersectBox(c.box, c.placeType) {
+			got = append(got, p.Dcid)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("IntersectBox(%+v) got diff: %v", c.box, diff)
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	if got := Distance(0, 0, 0, 1); math.Abs(got-111.195) > 1e-3 {
+		t.Errorf("Distance() = %v, want 111.195", got)
+	}
+	if got := Distance(-16.5, -179.9, -16.5, 179.9); got > 25 {
+		t.Errorf("Distance() across the antimeridian = %v, want < 25", got)
+	}
+}
+
+const features = `{
+  "type": "FeatureCollection",
+  "features": [
+    {
+      "properties": {"dcid": "country/USA", "name": "United States", "typeOf": ["Country"]},
+      "geometry": {
+        "type": "MultiPolygon",
+        "coordinates": [
+          [[[-125, 25], [-66, 25], [-66, 49], [-125, 49], [-125, 25]]],
+          [[[-170, 52], [-130, 52], [-130, 71], [-170, 71], [-170, 52]]]
+        ]
+      }
+    },
+    {
+      "properties": {"dcid": "geoId/06", "name": "California", "typeOf": ["State"]},
+      "geometry": {
+        "type": "Polygon",
+        "coordinates": [
+          [[-124.4, 32.5], [-114.1, 32.5], [-114.1, 42], [-124.4, 42], [-124.4, 32.5]],
+          [[-120, 35], [-119, 35], [-119, 36], [-120, 36], [-120, 35]]
+        ]
+      }
+    },
+    {
+      "properties": {"dcid": "geoId/06085", "name": "Santa Clara County", "typeOf": ["County"]},
+      "geometry": {
+        "type": "Polygon",
+        "coordinates": [[[-122.2, 36.9], [-121.2, 36.9], [-121.2, 37.5], [-122.2, 37.5], [-122.2, 36.9]]]
+      }
+    }
+  ]
+}`
+
+func TestContaining(t *testing.T) {
+	b := newBuilder()
+	if err := b.addFeatures([]byte(features)); err != nil {
+		t.Fatalf("addFeatures() got error %v", err)
+	}
+	index := NewIndex(b.build())
+	for _, c := range []struct {
+		lat, lng float64
+		want     []string
+	}{
+		{37.3, -121.9, []string{"geoId/06085", "geoId/06", "country/USA"}},
+		// In the hole of geoId/06.
+		{35.5, -119.5, []string{"country/USA"}},
+		{64.8, -147.7, []string{"country/USA"}},
+		{48.9, 2.4, []string{}},
+	} {
+		got := []string{}
+		for _, p := range index.Containing(c.lat, c.lng) {
+			got = append(got, p.Dcid)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("Containing(%v, %v) got diff: %v", c.lat, c.lng, diff)
+		}
+	}
+}
@@ -0,0 +1,415 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// geoJSON is a GeoJSON geometry of a place boundary.
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// featureCollection is a GeoJSON FeatureCollection of place boundaries. The
+// properties of a feature have the dcid, name and types of the place.
+type featureCollection struct {
+	Features []struct {
+		Properties struct {
+			Dcid   string   `json:"dcid"`
+			Name   string   `json:"name"`
+			TypeOf []string `json:"typeOf"`
+		} `json:"properties"`
+		Geometry *geoJSON `json:"geometry"`
+	} `json:"features"`
+}
+
+// parseGeometry parses the rings of a Polygon or MultiPolygon.
+func parseGeometry(geometry *geoJSON) ([][][2]float64, error) {
+	switch geometry.Type {
+	case "Polygon":
+		polygon := [][][2]float64{}
+		if err := json.Unmarshal(geometry.Coordinates, &polygon); err != nil {
+			return nil, err
+		}
+		return polygon, nil
+	case "MultiPolygon":
+		multiPolygon := [][][][2]float64{}
+		if err := json.Unmarshal(geometry.Coordinates, &multiPolygon); err != nil {
+			return nil, err
+		}
+		result := [][][2]float64{}
+		for _, polygon := range multiPolygon {
+			result = append(result, polygon...)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported geometry type %s", geometry.Type)
+	}
+}
+
+// kmlPolygon is a KML Polygon, each boundary is a list of "lng,lat[,alt]"
+// tuples separated by whitespace.
+type kmlPolygon struct {
+	Outer string   `xml:"outerBoundaryIs>LinearRing>coordinates"`
+	Inner []string `xml:"innerBoundaryIs>LinearRing>coordinates"`
+}
+
+// kmlPlacemark is a KML Placemark of a place boundary. The extended data has
+// the dcid and the comma separated types of the place, either as untyped Data
+// or as SimpleData of a schema.
+type kmlPlacemark struct {
+	Name string `xml:"name"`
+	Data []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value"`
+	} `xml:"ExtendedData>Data"`
+	SimpleData []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"ExtendedData>SchemaData>SimpleData"`
+	Polygons      []kmlPolygon `xml:"Polygon"`
+	MultiPolygons []kmlPolygon `xml:"MultiGeometry>Polygon"`
+}
+
+// data returns the extended data of a name.
+func (p *kmlPlacemark) data(name string) string {
+	for _, d := range p.Data {
+		if d.Name == name {
+			return strings.TrimSpace(d.Value)
+		}
+	}
+	for _, d := range p.SimpleData {
+		if d.Name == name {
+			return strings.TrimSpace(d.Value)
+		}
+	}
+	return ""
+}
+
+// parseKMLRing parses the coordinates of a KML LinearRing.
+func parseKMLRing(coordinates string) ([][2]float64, error) {
+	ring := [][2]float64{}
+	for _, tuple := range strings.Fields(coordinates) {
+		parts := strings.Split(tuple, ",")
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid coordinates %s", tuple)
+		}
+		lng, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return nil, err
+		}
+		lat, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		ring = append(ring, [2]float64{lng, lat})
+	}
+	return ring, nil
+}
+
+// builder merges the place data of the files of the index.
+type builder struct {
+	places map[string]*Place
+	hasLat map[string]bool
+	hasLng map[string]bool
+	order  []string
+}
+
+func newBuilder() *builder {
+	return &builder{
+		places: map[string]*Place{},
+		hasLat: map[string]bool{},
+		hasLng: map[string]bool{},
+	}
+}
+
+func (b *builder) place(dcid string) *Place {
+	p, ok := b.places[dcid]
+	if !ok {
+		p = &Place{Dcid: dcid}
+		b.places[dcid] = p
+		b.order = append(b.order, dcid)
+	}
+	return p
+}
+
+// addTriples adds the places of CSV triples of subject, predicate and object.
+// The typeOf, name, latitude, longitude and geoJsonCoordinates predicates are
+// used, others are ignored.
+func (b *builder) addTriples(r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.Comment = '#'
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		dcid := strings.TrimPrefix(record[0], "dcid:")
+		predicate, object := record[1], record[2]
+		p := b.place(dcid)
+		switch predicate {
+		case "typeOf":
+			p.Types = append(p.Types, strings.TrimPrefix(object, "dcid:"))
+		case "name":
+			p.Name = object
+		case "latitude":
+			if p.Latitude, err = strconv.ParseFloat(object, 64); err != nil {
+				return fmt.Errorf("invalid latitude of %s: %v", dcid, err)
+			}
+			b.hasLat[dcid] = true
+		case "longitude":
+			if p.Longitude, err = strconv.ParseFloat(object, 64); err != nil {
+				return fmt.Errorf("invalid longitude of %s: %v", dcid, err)
+			}
+			b.hasLng[dcid] = true
+		case "geoJsonCoordinates":
+			geometry := &geoJSON{}
+			if err := json.Unmarshal([]byte(object), geometry); err != nil {
+				return fmt.Errorf("invalid geoJsonCoordinates of %s: %v", dcid, err)
+			}
+			if p.Polygons, err = parseGeometry(geometry); err != nil {
+				return fmt.Errorf("invalid geoJsonCoordinates of %s: %v", dcid, err)
+			}
+		}
+	}
+}
+
+// addFeatures adds the places of a GeoJSON FeatureCollection.
+func (b *builder) addFeatures(data []byte) error {
+	collection := &featureCollection{}
+	if err := json.Unmarshal(data, collection); err != nil {
+		return err
+	}
+	for _, feature := range collection.Features {
+		dcid := feature.Properties.Dcid
+		if dcid == "" || feature.Geometry == nil {
+			continue
+		}
+		polygons, err := parseGeometry(feature.Geometry)
+		if err != nil {
+			return fmt.Errorf("invalid geometry of %s: %v", dcid, err)
+		}
+		p := b.place(dcid)
+		p.Polygons = polygons
+		if feature.Properties.Name != "" {
+			p.Name = feature.Properties.Name
+		}
+		p.Types = append(p.Types, feature.Properties.TypeOf...)
+	}
+	return nil
+}
+
+// addKML adds the places of the Placemarks of a KML document. Placemarks can
+// be nested in Documents and Folders.
+func (b *builder) addKML(data []byte) error {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "Placemark" {
+			continue
+		}
+		placemark := &kmlPlacemark{}
+		if err := decoder.DecodeElement(placemark, &start); err != nil {
+			return err
+		}
+		dcid := placemark.data("dcid")
+		polygons := append(placemark.Polygons, placemark.MultiPolygons...)
+		if dcid == "" || len(polygons) == 0 {
+			continue
+		}
+		rings := [][][2]float64{}
+		for _, polygon := range polygons {
+			for _, coordinates := range append([]string{polygon.Outer}, polygon.Inner...) {
+				ring, err := parseKMLRing(coordinates)
+				if err != nil {
+					return fmt.Errorf("invalid boundary of %s: %v", dcid, err)
+				}
+				rings = append(rings, ring)
+			}
+		}
+		p := b.place(dcid)
+		p.Polygons = rings
+		if placemark.Name != "" {
+			p.Name = strings.TrimSpace(placemark.Name)
+		}
+		for _, t := range strings.Split(placemark.data("typeOf"), ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				p.Types = append(p.Types, t)
+			}
+		}
+	}
+}
+
+// indexFileExts are the extensions of the files of the index.
+var indexFileExts = map[string]bool{
+	".csv":     true,
+	".json":    true,
+	".geojson": true,
+	".kml":     true,
+}
+
+// add adds the places of a file by its extension: CSV triples (.csv), GeoJSON
+// FeatureCollections (.json, .geojson) or KML (.kml). Returns false for other
+// extensions.
+func (b *builder) add(name string, data []byte) (bool, error) {
+	var err error
+	switch path.Ext(name) {
+	case ".csv":
+		err = b.addTriples(bytes.NewReader(data))
+	case ".json", ".geojson":
+		err = b.addFeatures(data)
+	case ".kml":
+		err = b.addKML(data)
+	default:
+		return false, nil
+	}
+	if err != nil {
+		return true, fmt.Errorf("failed to load %s: %v", name, err)
+	}
+	return true, nil
+}
+
+// addGcsPrefix adds the places of the files under a GCS prefix like
+// "gs://bucket/boundaries/". Files of other extensions are skipped.
+func (b *builder) addGcsPrefix(ctx context.Context, prefix string) error {
+	parts := strings.SplitN(strings.TrimPrefix(prefix, "gs://"), "/", 2)
+	if len(parts) != 2 {
+		parts = append(parts, "")
+	}
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	bkt := client.Bucket(parts[0])
+	it := bkt.Objects(ctx, &storage.Query{Prefix: parts[1]})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !indexFileExts[path.Ext(attrs.Name)] {
+			continue
+		}
+		r, err := bkt.Object(attrs.Name).NewReader(ctx)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+		if _, err := b.add("gs://"+parts[0]+"/"+attrs.Name, data); err != nil {
+			return err
+		}
+	}
+}
+
+// build returns the places. Places without a location use the center of their
+// boundary, and places without either are dropped.
+func (b *builder) build() []*Place {
+	result := []*Place{}
+	for _, dcid := range b.order {
+		p := b.places[dcid]
+		hasLocation := b.hasLat[dcid] && b.hasLng[dcid]
+		if len(p.Polygons) > 0 {
+			p.Bounds = ringBounds(p.Polygons)
+			p.Area = boundaryArea(p.Polygons)
+			if !hasLocation {
+				p.Latitude = (p.Bounds.MinLat + p.Bounds.MaxLat) / 2
+				p.Longitude = (p.Bounds.MinLng + p.Bounds.MaxLng) / 2
+			}
+		} else if hasLocation {
+			p.Bounds = Box{
+				MinLat: p.Latitude, MinLng: p.Longitude,
+				MaxLat: p.Latitude, MaxLng: p.Longitude,
+			}
+		} else {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// ParseTriples parses places from CSV triples of subject, predicate and
+// object.
+func ParseTriples(r io.Reader) ([]*Place, error) {
+	b := newBuilder()
+	if err := b.addTriples(r); err != nil {
+		return nil, err
+	}
+	return b.build(), nil
+}
+
+// LoadIndex builds the index used by the place APIs from files of CSV triples
+// (.csv), GeoJSON FeatureCollections (.json, .geojson) or KML (.kml). A path
+// like "gs://bucket/boundaries/" is a GCS prefix, all the files of these types
+// under it are loaded. The data of a place in several files is merged.
+func LoadIndex(ctx context.Context, paths ...string) error {
+	b := newBuilder()
+	for _, p := range paths {
+		if strings.HasPrefix(p, "gs://") {
+			if err := b.addGcsPrefix(ctx, p); err != nil {
+				return err
+			}
+			continue
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		ok, err := b.add(p, data)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf(
+				"unsupported spatial index file %s, should be .csv, .json, .geojson or .kml", p)
+		}
+	}
+	SetIndex(NewIndex(b.build()))
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const kml = `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+  <Document>
+    <Folder>
+      <Placemark>
+        <name>Santa Clara County</name>
+        <ExtendedData>
+          <Data name="dcid"><value>geoId/06085</value></Data>
+          <Data name="typeOf"><value>County, AdministrativeArea2</value></Data>
+        </ExtendedData>
+        <Polygon>
+          <outerBoundaryIs><LinearRing><coordinates>
+            -122.2,36.9,0 -121.2,36.9,0 -121.2,37.5,0 -122.2,37.5,0 -122.2,36.9,0
+          </coordinates></LinearRing></outerBoundaryIs>
+          <innerBoundaryIs><LinearRing><coordinates>
+            -121.8,37.1 -121.6,37.1 -121.6,37.3 -121.8,37.3 -121.8,37.1
+          </coordinates></LinearRing></innerBoundaryIs>
+        </Polygon>
+      </Placemark>
+    </Folder>
+    <Placemark>
+      <name>Islands</name>
+      <ExtendedData>
+        <SchemaData schemaUrl="#places">
+          <SimpleData name="dcid">wikidataId/Q1</SimpleData>
+        </SchemaData>
+      </ExtendedData>
+      <MultiGeometry>
+        <Polygon>
+          <outerBoundaryIs><LinearRing><coordinates>
+            178,-18 179,-18 179,-17 178,-17 178,-18
+          </coordinates></LinearRing></outerBoundaryIs>
+        </Polygon>
+        <Polygon>
+          <outerBoundaryIs><LinearRing><coordinates>
+            -180,-17 -179,-17 -179,-16 -180,-16 -180,-17
+          </coordinates></LinearRing></outerBoundaryIs>
+        </Polygon>
+      </MultiGeometry>
+    </Placemark>
+    <Placemark>
+      <name>No dcid</name>
+      <Point><coordinates>0,0</coordinates></Point>
+    </Placemark>
+  </Document>
+</kml>`
+
+func TestAddKML(t *testing.T) {
+	b := newBuilder()
+	if err := b.addKML([]byte(kml)); err != nil {
+		t.Fatalf("addKML() got error %v", err)
+	}
+	places := b.build()
+	got := map[string]*Place{}
+	for _, p := range places {
+		got[p.Dcid] = p
+	}
+	if len(got) != 2 {
+		t.Fatalf("addKML() got %d places, want 2", len(got))
+	}
+
+	county := got["geoId/06085"]
+	if county.Name != "Santa Clara County" {
+		t.Errorf("addKML() got name %s", county.Name)
+	}
+	if diff := cmp.Diff(county.Types, []string{"County", "AdministrativeArea2"}); diff != "" {
+		t.Errorf("addKML() got diff types %v", diff)
+	}
+	wantRings := [][][2]float64{
+		{{-122.2, 36.9}, {-121.2, 36.9}, {-121.2, 37.5}, {-122.2, 37.5}, {-122.2, 36.9}},
+		{{-121.8, 37.1}, {-121.6, 37.1}, {-121.6, 37.3}, {-121.8, 37.3}, {-121.8, 37.1}},
+	}
+	if diff := cmp.Diff(county.Polygons, wantRings); diff != "" {
+		t.Errorf("addKML() got diff polygons %v", diff)
+	}
+
+	islands := got["wikidataId/Q1"]
+	if len(islands.Polygons) != 2 {
+		t.Errorf("addKML() got %d rings of a MultiGeometry, want 2", len(islands.Polygons))
+	}
+
+	b = newBuilder()
+	bad := `<kml><Placemark><ExtendedData><Data name="dcid"><value>geoId/06</value></Data>` +
+		`</ExtendedData><Polygon><outerBoundaryIs><LinearRing><coordinates>1 2` +
+		`</coordinates></LinearRing></outerBoundaryIs></Polygon></Placemark></kml>`
+	if err := b.addKML([]byte(bad)); err == nil {
+		t.Errorf("addKML() should return error for invalid coordinates")
+	}
+}
+
+func TestAdd(t *testing.T) {
+	b := newBuilder()
+	for _, c := range []struct {
+		name string
+		data string
+		ok   bool
+	}{
+		{"places.csv", triples, true},
+		{"boundaries.kml", kml, true},
+		{"boundaries.kmz", "", false},
+	} {
+		ok, err := b.add(c.name, []byte(c.data))
+		if err != nil {
+			t.Fatalf("add(%s) got error %v", c.name, err)
+		}
+		if ok != c.ok {
+			t.Errorf("add(%s) got %t, want %t", c.name, ok, c.ok)
+		}
+	}
+	// Data of a place in several files is merged.
+	for _, p := range b.build() {
+		if p.Dcid == "geoId/06085" && p.Name != "Santa Clara County" {
+			t.Errorf("add() got name %q of geoId/06085", p.Name)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+// rtreeNodeSize is the max number of entries of an R-tree node.
+const rtreeNodeSize = 16
+
+// rtreeEntry is a place in a leaf node, or a child node in an inner node.
+type rtreeEntry struct {
+	// Box of the place or of all the entries of the child. Never crosses the
+	// antimeridian.
+	box   Box
+	place *Place
+	child *rtreeNode
+}
+
+// rtreeNode is a node of a static R-tree.
+type rtreeNode struct {
+	entries []rtreeEntry
+}
+
+// splitBox splits a box crossing the antimeridian in two.
+func splitBox(b Box) []Box {
+	if b.MinLng <= b.MaxLng {
+		return []Box{b}
+	}
+	return []Box{
+		{MinLat: b.MinLat, MinLng: b.MinLng, MaxLat: b.MaxLat, MaxLng: 180},
+		{MinLat: b.MinLat, MinLng: -180, MaxLat: b.MaxLat, MaxLng: b.MaxLng},
+	}
+}
+
+// unionBox returns the bounding box of the entries.
+func unionBox(entries []rtreeEntry) Box {
+	result := entries[0].box
+	for _, e := range entries[1:] {
+		result.MinLat = math.Min(result.MinLat, e.box.MinLat)
+		result.MinLng = math.Min(result.MinLng, e.box.MinLng)
+		result.MaxLat = math.Max(result.MaxLat, e.box.MaxLat)
+		result.MaxLng = math.Max(result.MaxLng, e.box.MaxLng)
+	}
+	return result
+}
+
+// packLevel groups the entries of a level into nodes by Sort-Tile-Recursive:
+// the entries are sorted by longitude into vertical slices, and each slice is
+// sorted by latitude and cut into nodes. Returns the entries of the nodes.
+func packLevel(entries []rtreeEntry) []rtreeEntry {
+	centerLng := func(e rtreeEntry) float64 { return (e.box.MinLng + e.box.MaxLng) / 2 }
+	centerLat := func(e rtreeEntry) float64 { return (e.box.MinLat + e.box.MaxLat) / 2 }
+	numNodes := (len(entries) + rtreeNodeSize - 1) / rtreeNodeSize
+	numSlices := int(math.Ceil(math.Sqrt(float64(numNodes))))
+	sliceSize := numSlices * rtreeNodeSize
+	sort.Slice(entries, func(i, j int) bool {
+		return centerLng(entries[i]) < centerLng(entries[j])
+	})
+	result := []rtreeEntry{}
+	for start := 0; start < len(entries); start += sliceSize {
+		end := start + sliceSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+		slice := entries[start:end]
+		sort.Slice(slice, func(i, j int) bool {
+			return centerLat(slice[i]) < centerLat(slice[j])
+		})
+		for i := 0; i < len(slice); i += rtreeNodeSize {
+			j := i + rtreeNodeSize
+			if j > len(slice) {
+				j = len(slice)
+			}
+			node := &rtreeNode{entries: append([]rtreeEntry{}, slice[i:j]...)}
+			result = append(result, rtreeEntry{box: unionBox(node.entries), child: node})
+		}
+	}
+	return result
+}
+
+// newRtree bulk loads an R-tree of the places, by their bounding box. Places
+// whose box crosses the antimeridian have an entry for each side.
+func newRtree(places []*Place) *rtreeNode {
+	entries := []rtreeEntry{}
+	for _, p := range places {
+		for _, b := range splitBox(p.Bounds) {
+			entries = append(entries, rtreeEntry{box: b, place: p})
+		}
+	}
+	if len(entries) == 0 {
+		return &rtreeNode{}
+	}
+	for len(entries) > rtreeNodeSize {
+		entries = packLevel(entries)
+	}
+	return &rtreeNode{entries: entries}
+}
+
+// search calls fn with the places whose box overlaps the box, which does not
+// cross the antimeridian. A place can be visited more than once.
+func (node *rtreeNode) search(b Box, fn func(p *Place)) {
+	for _, e := range node.entries {
+		if !e.box.overlaps(b) {
+			continue
+		}
+		if e.child != nil {
+			e.child.search(b, fn)
+		} else {
+			fn(e.place)
+		}
+	}
+}
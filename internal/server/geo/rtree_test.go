@@ -0,0 +1,77 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRtree(t *testing.T) {
+	// A grid of places with one degree boxes, and one crossing the antimeridian.
+	places := []*Place{}
+	for lat := -60; lat < 60; lat += 3 {
+		for lng := -180; lng < 180; lng += 7 {
+			places = append(places, &Place{
+				Dcid: fmt.Sprintf("%d,%d", lat, lng),
+				Bounds: Box{
+					MinLat: float64(lat), MinLng: float64(lng),
+					MaxLat: float64(lat + 1), MaxLng: float64(lng + 1),
+				},
+			})
+		}
+	}
+	places = append(places, &Place{
+		Dcid:   "antimeridian",
+		Bounds: Box{MinLat: -20, MinLng: 179, MaxLat: -15, MaxLng: -179},
+	})
+	root := newRtree(places)
+	if len(root.entries) == 0 || root.entries[0].child == nil {
+		t.Fatalf("newRtree() got a single level tree")
+	}
+
+	for _, b := range []Box{
+		{MinLat: 10, MinLng: 20, MaxLat: 20, MaxLng: 40},
+		{MinLat: -90, MinLng: -180, MaxLat: 90, MaxLng: 180},
+		{MinLat: -18, MinLng: -179.5, MaxLat: -17, MaxLng: -179.5},
+		{MinLat: 70, MinLng: 0, MaxLat: 80, MaxLng: 10},
+	} {
+		want := []string{}
+		for _, p := range places {
+			for _, pb := range splitBox(p.Bounds) {
+				if pb.overlaps(b) {
+					want = append(want, p.Dcid)
+					break
+				}
+			}
+		}
+		seen := map[string]bool{}
+		got := []string{}
+		root.search(b, func(p *Place) {
+			if !seen[p.Dcid] {
+				seen[p.Dcid] = true
+				got = append(got, p.Dcid)
+			}
+		})
+		sort.Strings(got)
+		sort.Strings(want)
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("search(%+v) got diff: %v", b, diff)
+		}
+	}
+}
@@ -75,6 +75,22 @@ func (s *Server) GetStatSetWithinPlaceAll(
 	return stat.GetStatSetWithinPlaceAll(ctx, in, s.store)
 }
 
+// GetStatDistribution implements API for Mixer.GetStatDistribution.
+// Endpoint: /stat/distribution
+func (s *Server) GetStatDistribution(
+	ctx context.Context, in *pb.GetStatDistributionRequest,
+) (*pb.GetStatDistributionResponse, error) {
+	return stat.GetStatDistribution(ctx, in, s.store)
+}
+
+// GetStatCorrelation implements API for Mixer.GetStatCorrelation.
+// Endpoint: /stat/correlation
+func (s *Server) GetStatCorrelation(
+	ctx context.Context, in *pb.GetStatCorrelationRequest,
+) (*pb.GetStatCorrelationResponse, error) {
+	return stat.GetStatCorrelation(ctx, in, s.store)
+}
+
 // GetStatSeries implements API for Mixer.GetStatSeries.
 // Endpoint: /stat/series
 // TODO(shifucun): consilidate and dedup the logic among these similar APIs.
@@ -122,6 +138,12 @@ func (s *Server) GetPlacesIn(ctx context.Context, in *pb.GetPlacesInRequest,
 	return place.GetPlacesIn(ctx, in, s.store)
 }
 
+// GetPlacesInArea implements API for Mixer.GetPlacesInArea.
+func (s *Server) GetPlacesInArea(ctx context.Context, in *pb.GetPlacesInAreaRequest,
+) (*pb.GetPlacesInAreaResponse, error) {
+	return place.GetPlacesInArea(ctx, in, s.store)
+}
+
 // GetRelatedLocations implements API for Mixer.GetRelatedLocations.
 func (s *Server) GetRelatedLocations(
 	ctx context.Context, in *pb.GetRelatedLocationsRequest,
@@ -143,6 +165,13 @@ func (s *Server) GetPlaceMetadata(
 	return place.GetPlaceMetadata(ctx, in, s.store)
 }
 
+// ResolveCoordinates implements API for Mixer.ResolveCoordinates.
+func (s *Server) ResolveCoordinates(
+	ctx context.Context, in *pb.ResolveCoordinatesRequest,
+) (*pb.ResolveCoordinatesResponse, error) {
+	return place.ResolveCoordinates(ctx, in, s.store)
+}
+
 // GetPlaceStatDateWithinPlace implements API for Mixer.GetPlaceStatDateWithinPlace.
 // Endpoint: /place/stat/date/within-place
 func (s *Server) GetPlaceStatDateWithinPlace(
@@ -228,6 +257,12 @@ func (s *Server) GetTriples(ctx context.Context, in *pb.GetTriplesRequest,
 	return node.GetTriples(ctx, in, s.store, s.metadata)
 }
 
+// Resolve implements API for Mixer.Resolve.
+func (s *Server) Resolve(ctx context.Context, in *pb.ResolveRequest,
+) (*pb.ResolveResponse, error) {
+	return node.Resolve(ctx, in, s.store)
+}
+
 // GetPlacePageData implements API for Mixer.GetPlacePageData.
 //
 // TODO(shifucun):For each related place, it is supposed to have dcid, name and
@@ -266,3 +301,18 @@ func (s *Server) GetVersion(
 		GitHash:  os.Getenv("MIXER_HASH"),
 	}, nil
 }
+
+// GetMemDbStats implements API for Mixer.GetMemDbStats.
+func (s *Server) GetMemDbStats(
+	ctx context.Context, in *pb.GetMemDbStatsRequest,
+) (*pb.GetMemDbStatsResponse, error) {
+	stats := s.store.MemDb.Stats()
+	return &pb.GetMemDbStatsResponse{
+		NumStatVars:     int64(stats.NumStatVars),
+		NumPlaces:       int64(stats.NumPlaces),
+		NumDates:        int64(stats.NumDates),
+		NumSeries:       int64(stats.NumSeries),
+		NumObservations: int64(stats.NumObservations),
+		ApproxBytes:     stats.ApproxBytes,
+	}, nil
+}
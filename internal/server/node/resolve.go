@@ -0,0 +1,168 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"regexp"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/model"
+	"github.com/datacommonsorg/mixer/internal/server/placeindex"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// FIPS codes are the suffix of the geoId dcids.
+	idTypeFips           = "fips"
+	defaultMaxCandidates = 5
+)
+
+var (
+	fipsRegex   = regexp.MustCompile(`^[0-9]+$`)
+	idTypeRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+)
+
+// resolveIDs resolves external IDs to dcids, keyed by ID. An ID of a property
+// shared by several nodes has a candidate for each of them, with the same
+// confidence.
+func resolveIDs(
+	idType string,
+	values []string,
+	// Reads the property values of nodes, like GetPropertyValuesHelper.
+	readValues func(dcids []string, prop string, arcOut bool) (map[string][]*model.Node, error),
+) (map[string][]*pb.ResolveCandidate, error) {
+	result := map[string][]*pb.ResolveCandidate{}
+	if idType == idTypeFips {
+		dcids := []string{}
+		for _, v := range values {
+			if fipsRegex.MatchString(v) {
+				dcids = append(dcids, "geoId/"+v)
+			}
+		}
+		if len(dcids) == 0 {
+			return result, nil
+		}
+		// Only return the geoId dcids of existing nodes.
+		typeNodes, err := readValues(dcids, "typeOf", true)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range values {
+			nodes, ok := typeNodes["geoId/"+v]
+			if !ok || len(nodes) == 0 {
+				continue
+			}
+			candidate := &pb.ResolveCandidate{Dcid: "geoId/" + v, Confidence: 1}
+			for _, n := range nodes {
+				candidate.Types = append(candidate.Types, n.Dcid)
+			}
+			result[v] = []*pb.ResolveCandidate{candidate}
+		}
+		return result, nil
+	}
+	// The nodes with an ID are the in-arc property values of the ID.
+	nodesByValue, err := readValues(values, idType, false)
+	if err != nil {
+		return nil, err
+	}
+	for value, nodes := range nodesByValue {
+		for _, n := range nodes {
+			result[value] = append(result[value], &pb.ResolveCandidate{
+				Dcid:       n.Dcid,
+				Name:       n.Name,
+				Types:      n.Types,
+				Confidence: 1 / float64(len(nodes)),
+			})
+		}
+	}
+	return result, nil
+}
+
+// resolveName resolves a place name to dcids from the place index.
+func resolveName(
+	index *placeindex.Index, name, placeType, containedIn string,
+) []*pb.ResolveCandidate {
+	result := []*pb.ResolveCandidate{}
+	for _, m := range index.MatchName(name, placeType, containedIn) {
+		result = append(result, &pb.ResolveCandidate{
+			Dcid:       m.Place.Dcid,
+			Name:       m.Place.Name,
+			Types:      m.Place.Types,
+			Confidence: m.Confidence,
+		})
+	}
+	return result
+}
+
+// Resolve implements API for Mixer.Resolve.
+func Resolve(ctx context.Context, in *pb.ResolveRequest, store *store.Store) (
+	*pb.ResolveResponse, error) {
+	idType := in.GetIdType()
+	maxCandidates := int(in.GetMaxCandidates())
+	if maxCandidates <= 0 {
+		maxCandidates = defaultMaxCandidates
+	}
+	index := placeindex.CurrentIndex()
+	result := &pb.ResolveResponse{}
+	addEntity := func(value string, candidates []*pb.ResolveCandidate) {
+		if len(candidates) > maxCandidates {
+			candidates = candidates[:maxCandidates]
+		}
+		for _, c := range candidates {
+			if p := index.Place(c.Dcid); p != nil && c.Name == "" {
+				c.Name = p.Name
+			}
+		}
+		result.Entities = append(result.Entities, &pb.ResolveResponse_Entity{
+			Value:      value,
+			Candidates: candidates,
+		})
+	}
+
+	if idType != "" {
+		values := in.GetValues()
+		if len(values) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Missing required argument: values")
+		}
+		if !idTypeRegex.MatchString(idType) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid id_type: %s", idType)
+		}
+		candidates, err := resolveIDs(idType, values,
+			func(dcids []string, prop string, arcOut bool) (map[string][]*model.Node, error) {
+				return GetPropertyValuesHelper(ctx, store, dcids, prop, arcOut)
+			})
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			addEntity(value, candidates[value])
+		}
+		return result, nil
+	}
+
+	names := in.GetNames()
+	if len(names) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: id_type and values, or names")
+	}
+	for _, name := range names {
+		addEntity(name, resolveName(index, name, in.GetType(), in.GetContainedInPlace()))
+	}
+	return result, nil
+}
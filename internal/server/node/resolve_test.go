@@ -0,0 +1,134 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/model"
+	"github.com/datacommonsorg/mixer/internal/server/placeindex"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestResolveIDs(t *testing.T) {
+	// Keyed by property and arc direction, then by node or value.
+	values := map[string]map[string][]*model.Node{
+		"typeOf/out": {
+			"geoId/06": {{Dcid: "State"}},
+		},
+		"wikidataId/in": {
+			"Q99": {{Dcid: "geoId/06", Name: "California", Types: []string{"State"}}},
+			"Q1": {
+				{Dcid: "dc/a", Name: "A", Types: []string{"City"}},
+				{Dcid: "dc/b", Name: "B", Types: []string{"City"}},
+			},
+		},
+	}
+	readValues := func(dcids []string, prop string, arcOut bool) (map[string][]*model.Node, error) {
+		key := prop + "/in"
+		if arcOut {
+			key = prop + "/out"
+		}
+		result := map[string][]*model.Node{}
+		for _, dcid := range dcids {
+			if nodes, ok := values[key][dcid]; ok {
+				result[dcid] = nodes
+			}
+		}
+		return result, nil
+	}
+	for _, c := range []struct {
+		idType string
+		values []string
+		want   map[string][]*pb.ResolveCandidate
+	}{
+		{
+			"fips",
+			// Only FIPS codes of existing nodes are resolved.
+			[]string{"06", "99", "abc"},
+			map[string][]*pb.ResolveCandidate{
+				"06": {{Dcid: "geoId/06", Types: []string{"State"}, Confidence: 1}},
+			},
+		},
+		{
+			"wikidataId",
+			[]string{"Q99", "Q1", "Q2"},
+			map[string][]*pb.ResolveCandidate{
+				"Q99": {
+					{Dcid: "geoId/06", Name: "California", Types: []string{"State"}, Confidence: 1},
+				},
+				"Q1": {
+					{Dcid: "dc/a", Name: "A", Types: []string{"City"}, Confidence: 0.5},
+					{Dcid: "dc/b", Name: "B", Types: []string{"City"}, Confidence: 0.5},
+				},
+			},
+		},
+	} {
+		got, err := resolveIDs(c.idType, c.values, readValues)
+		if err != nil {
+			t.Errorf("resolveIDs(%s) got error %v", c.idType, err)
+			continue
+		}
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("resolveIDs(%s) got diff: %v", c.idType, diff)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	ctx := context.Background()
+	// The arguments are checked before reading the store.
+	for _, in := range []*pb.ResolveRequest{
+		{},
+		{IdType: "fips"},
+		{IdType: "wikidata Id", Values: []string{"Q1"}},
+	} {
+		if _, err := Resolve(ctx, in, nil); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Resolve(%v) got error %v, want InvalidArgument", in, err)
+		}
+	}
+
+	placeindex.SetIndex(placeindex.NewIndex([]*placeindex.Place{
+		{Dcid: "geoId/0668000", Name: "San Jose", Types: []string{"City"}, Population: 1021795},
+		{Dcid: "wikidataId/Q3070", Name: "San José", Types: []string{"City"}, Population: 288054},
+	}))
+	defer placeindex.SetIndex(placeindex.NewIndex(nil))
+	got, err := Resolve(ctx, &pb.ResolveRequest{
+		Names:         []string{"San Jose", "Paris"},
+		MaxCandidates: 1,
+	}, nil)
+	if err != nil {
+		t.Fatalf("Resolve() got error %v", err)
+	}
+	want := &pb.ResolveResponse{
+		Entities: []*pb.ResolveResponse_Entity{
+			{
+				Value: "San Jose",
+				Candidates: []*pb.ResolveCandidate{
+					{Dcid: "geoId/0668000", Name: "San Jose", Types: []string{"City"}, Confidence: 1},
+				},
+			},
+			{Value: "Paris"},
+		},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Resolve() got diff: %v", diff)
+	}
+}
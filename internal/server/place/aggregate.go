@@ -0,0 +1,83 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+)
+
+// AggregateMemDb rolls up private import data to the ancestor places.
+//
+// Only stat vars declared with sum aggregation in the import manifest are
+// aggregated. For each ancestor of an imported place, the values of all its
+// child places of the imported place type are summed, so a county level import
+// yields state and country level data.
+func AggregateMemDb(ctx context.Context, store *store.Store) error {
+	memDb := store.MemDb
+	statVars := memDb.GetSummableStatVars()
+	if len(statVars) == 0 {
+		return nil
+	}
+	places := memDb.GetPlaces(statVars)
+	if len(places) == 0 {
+		return nil
+	}
+	resp, err := GetPlaceMetadata(
+		ctx, &pb.GetPlaceMetadataRequest{Places: places}, store)
+	if err != nil {
+		return err
+	}
+	type ancestor struct {
+		dcid      string
+		childType string
+	}
+	ancestorSet := map[ancestor]struct{}{}
+	for _, meta := range resp.GetData() {
+		childType := meta.GetSelf().GetType()
+		if childType == "" {
+			continue
+		}
+		for _, parent := range meta.GetParents() {
+			ancestorSet[ancestor{parent.GetDcid(), childType}] = struct{}{}
+		}
+	}
+	ancestors := make([]ancestor, 0, len(ancestorSet))
+	for a := range ancestorSet {
+		ancestors = append(ancestors, a)
+	}
+	sort.Slice(ancestors, func(i, j int) bool {
+		if ancestors[i].childType != ancestors[j].childType {
+			return ancestors[i].childType < ancestors[j].childType
+		}
+		return ancestors[i].dcid < ancestors[j].dcid
+	})
+	count := 0
+	for _, a := range ancestors {
+		children, err := GetChildPlaces(ctx, store, a.dcid, a.childType)
+		if err != nil {
+			return err
+		}
+		for _, statVar := range statVars {
+			count += memDb.AddSum(statVar, a.dcid, children)
+		}
+	}
+	log.Printf("Aggregated %d observations to %d ancestor places", count, len(ancestors))
+	return nil
+}
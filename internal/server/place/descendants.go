@@ -0,0 +1,101 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"context"
+	"sort"
+
+	"github.com/datacommonsorg/mixer/internal/server/model"
+	"github.com/datacommonsorg/mixer/internal/server/node"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Default number of containedInPlace levels walked by GetPlacesIn.
+	defaultPlacesInDepth = 3
+	// Max number of containedInPlace levels walked by GetPlacesIn.
+	maxPlacesInDepth = 6
+	// Max number of places read by a GetPlacesIn walk.
+	maxPlacesInNodes = 200000
+)
+
+// descendants walks the children of the ancestor place level by level, up to
+// maxDepth levels, and returns the paths from the ancestor to the places of the
+// place type, sorted by place. Places of the place type are not walked further.
+// The walk fails when it reads more than maxNodes places.
+func descendants(
+	ancestor, placeType string,
+	maxDepth, maxNodes int,
+	// Reads the children of a list of places.
+	readChildren func(dcids []string) (map[string][]*model.Node, error),
+) ([][]string, error) {
+	paths := map[string][]string{ancestor: {ancestor}}
+	frontier := []string{ancestor}
+	result := [][]string{}
+	for depth := 0; depth < maxDepth && len(frontier) > 0; depth++ {
+		children, err := readChildren(frontier)
+		if err != nil {
+			return nil, err
+		}
+		next := []string{}
+		for _, parent := range frontier {
+			for _, child := range children[parent] {
+				if _, ok := paths[child.Dcid]; ok {
+					continue
+				}
+				if len(paths) > maxNodes {
+					return nil, status.Errorf(codes.InvalidArgument,
+						"More than %d places within %s, try a smaller max_depth or ancestor",
+						maxNodes, ancestor)
+				}
+				path := append(append([]string{}, paths[parent]...), child.Dcid)
+				paths[child.Dcid] = path
+				if hasType(child.Types, placeType) {
+					result = append(result, path)
+				} else {
+					next = append(next, child.Dcid)
+				}
+			}
+		}
+		frontier = next
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i][len(result[i])-1] < result[j][len(result[j])-1]
+	})
+	return result, nil
+}
+
+func hasType(types []string, placeType string) bool {
+	for _, t := range types {
+		if t == placeType {
+			return true
+		}
+	}
+	return false
+}
+
+// walkDescendants returns the paths from the ancestor place to the places of
+// the place type, by walking containedInPlace.
+func walkDescendants(
+	ctx context.Context, store *store.Store, ancestor, placeType string, maxDepth int,
+) ([][]string, error) {
+	return descendants(ancestor, placeType, maxDepth, maxPlacesInNodes,
+		func(dcids []string) (map[string][]*model.Node, error) {
+			return node.GetPropertyValuesHelper(ctx, store, dcids, "containedInPlace", false)
+		})
+}
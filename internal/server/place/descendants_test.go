@@ -0,0 +1,98 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/server/model"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDescendants(t *testing.T) {
+	children := map[string][]*model.Node{
+		"country/USA": {
+			{Dcid: "geoId/06", Types: []string{"State"}},
+			{Dcid: "geoId/53", Types: []string{"State"}},
+		},
+		"geoId/06": {
+			{Dcid: "geoId/06085", Types: []string{"County"}},
+		},
+		"geoId/53": {
+			{Dcid: "geoId/53033", Types: []string{"County"}},
+		},
+		"geoId/06085": {
+			{Dcid: "geoId/06085500100", Types: []string{"CensusTract"}},
+		},
+		"geoId/53033": {
+			{Dcid: "geoId/53033000100", Types: []string{"CensusTract"}},
+			{Dcid: "geoId/53033000200", Types: []string{"CensusTract"}},
+		},
+		"geoId/53033000200": {
+			{Dcid: "geoId/530330002001", Types: []string{"CensusBlockGroup"}},
+		},
+	}
+	readChildren := func(dcids []string) (map[string][]*model.Node, error) {
+		result := map[string][]*model.Node{}
+		for _, dcid := range dcids {
+			result[dcid] = children[dcid]
+		}
+		return result, nil
+	}
+	for _, c := range []struct {
+		placeType string
+		maxDepth  int
+		want      [][]string
+	}{
+		{
+			"CensusTract",
+			3,
+			[][]string{
+				{"country/USA", "geoId/06", "geoId/06085", "geoId/06085500100"},
+				{"country/USA", "geoId/53", "geoId/53033", "geoId/53033000100"},
+				{"country/USA", "geoId/53", "geoId/53033", "geoId/53033000200"},
+			},
+		},
+		{
+			"CensusTract",
+			2,
+			[][]string{},
+		},
+		{
+			"County",
+			6,
+			[][]string{
+				{"country/USA", "geoId/06", "geoId/06085"},
+				{"country/USA", "geoId/53", "geoId/53033"},
+			},
+		},
+	} {
+		got, err := descendants("country/USA", c.placeType, c.maxDepth, 100, readChildren)
+		if err != nil {
+			t.Errorf("descendants(%s, %d) got error %v", c.placeType, c.maxDepth, err)
+			continue
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("descendants(%s, %d) got diff: %v", c.placeType, c.maxDepth, diff)
+		}
+	}
+	// The walk reads 7 places below the ancestor to reach the tracts.
+	_, err := descendants("country/USA", "CensusTract", 3, 6, readChildren)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("descendants() over the max places got error %v, want InvalidArgument", err)
+	}
+}
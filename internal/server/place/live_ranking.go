@@ -0,0 +1,225 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"context"
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+)
+
+const (
+	// Parent place of live rankings when within_place is not set.
+	defaultRankingParent = "Earth"
+	// Default number of places in the top and bottom live rankings.
+	defaultRankingTopN = 1000
+	// Denominator of per capita live rankings.
+	perCapitaStatVar = "Count_Person"
+)
+
+// readTopCohorts reads the best ranked source cohort of the child places for
+// each stat var. Values from a single source are used, so the places are
+// comparable.
+func readTopCohorts(
+	ctx context.Context,
+	store *store.Store,
+	parentPlace, childType, dateKey string,
+	statVars []string,
+) (map[string]*pb.SourceSeries, error) {
+	rowList, keyTokens := bigtable.BuildObsCollectionKey(
+		parentPlace, childType, dateKey, statVars)
+	cacheData, err := bigtable.ReadStatCollection(ctx, store.BtGroup, rowList, keyTokens)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]*pb.SourceSeries{}
+	for statVar, data := range cacheData {
+		if data == nil {
+			continue
+		}
+		cohorts := ranking.SortCohorts(data.SourceCohorts, ranking.Options{
+			StatVar:   statVar,
+			PlaceType: childType,
+		})
+		if len(cohorts) > 0 {
+			result[statVar] = cohorts[0]
+		}
+	}
+	return result, nil
+}
+
+// readPopulation reads the population of the child places, of the date or the
+// latest one.
+func readPopulation(
+	ctx context.Context, store *store.Store, parentPlace, childType, dateKey string,
+) (map[string]float64, error) {
+	keys := []string{"LATEST"}
+	if dateKey != "LATEST" {
+		keys = append(keys, dateKey)
+	}
+	result := map[string]float64{}
+	for _, key := range keys {
+		cohorts, err := readTopCohorts(
+			ctx, store, parentPlace, childType, key, []string{perCapitaStatVar})
+		if err != nil {
+			return nil, err
+		}
+		if cohort, ok := cohorts[perCapitaStatVar]; ok {
+			for place, value := range cohort.Val {
+				result[place] = value
+			}
+		}
+	}
+	return result, nil
+}
+
+// perCapita divides the values by the population, dropping places without
+// population.
+func perCapita(values, population map[string]float64) map[string]float64 {
+	result := map[string]float64{}
+	for place, value := range values {
+		if denom, ok := population[place]; ok && denom != 0 {
+			result[place] = value / denom
+		}
+	}
+	return result
+}
+
+// rankValues ranks the places by value from the largest. Places with the same
+// value share the same rank, and the next rank skips the tied places.
+func rankValues(values map[string]float64) []*pb.RelatedPlacesInfo_Ranking_RankInfo {
+	result := make([]*pb.RelatedPlacesInfo_Ranking_RankInfo, 0, len(values))
+	for place, value := range values {
+		result = append(result, &pb.RelatedPlacesInfo_Ranking_RankInfo{
+			PlaceDcid: place,
+			Value:     value,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Value != result[j].Value {
+			return result[i].Value > result[j].Value
+		}
+		return result[i].PlaceDcid < result[j].PlaceDcid
+	})
+	n := len(result)
+	for i := 0; i < n; {
+		// Places in [i, j) are tied.
+		j := i + 1
+		for j < n && result[j].Value == result[i].Value {
+			j++
+		}
+		below := n - j
+		for k := i; k < j; k++ {
+			result[k].Rank = int32(i + 1)
+			result[k].Percentile = 100 * (float64(below) + 0.5*float64(j-i)) / float64(n)
+		}
+		i = j
+	}
+	return result
+}
+
+// topWithTies returns the first n ranked places, and the places tied with the
+// last one.
+func topWithTies(
+	infos []*pb.RelatedPlacesInfo_Ranking_RankInfo, n int,
+) []*pb.RelatedPlacesInfo_Ranking_RankInfo {
+	if n >= len(infos) {
+		return infos
+	}
+	end := n
+	for end < len(infos) && infos[end].Value == infos[n-1].Value {
+		end++
+	}
+	return infos[:end]
+}
+
+// bottomWithTies returns the last n ranked places, and the places tied with
+// the first one.
+func bottomWithTies(
+	infos []*pb.RelatedPlacesInfo_Ranking_RankInfo, n int,
+) []*pb.RelatedPlacesInfo_Ranking_RankInfo {
+	if n >= len(infos) {
+		return infos
+	}
+	start := len(infos) - n
+	for start > 0 && infos[start-1].Value == infos[start].Value {
+		start--
+	}
+	return infos[start:]
+}
+
+// getLiveRankings computes the rankings of the places of a type within a
+// parent place from the obs collection cache.
+func getLiveRankings(
+	ctx context.Context, in *pb.GetLocationsRankingsRequest, store *store.Store,
+) (*pb.GetLocationsRankingsResponse, error) {
+	parentPlace := in.GetWithinPlace()
+	if parentPlace == "" {
+		parentPlace = defaultRankingParent
+	}
+	childType := in.GetPlaceType()
+	dateKey := in.GetDate()
+	if dateKey == "" {
+		dateKey = "LATEST"
+	}
+	topN := int(in.GetTopN())
+	if topN <= 0 {
+		topN = defaultRankingTopN
+	}
+	statVars := in.GetStatVarDcids()
+	cohorts, err := readTopCohorts(ctx, store, parentPlace, childType, dateKey, statVars)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]map[string]float64{}
+	for statVar, cohort := range cohorts {
+		values[statVar] = cohort.Val
+	}
+	if in.GetIsPerCapita() {
+		denoms, err := readPopulation(ctx, store, parentPlace, childType, dateKey)
+		if err != nil {
+			return nil, err
+		}
+		for statVar, placeValues := range values {
+			values[statVar] = perCapita(placeValues, denoms)
+		}
+	}
+
+	result := &pb.GetLocationsRankingsResponse{
+		Payload: map[string]*pb.RelatedPlacesInfo{},
+	}
+	for _, statVar := range statVars {
+		placeValues, ok := values[statVar]
+		if !ok || len(placeValues) == 0 {
+			result.Payload[statVar] = nil
+			continue
+		}
+		infos := rankValues(placeValues)
+		result.Payload[statVar] = &pb.RelatedPlacesInfo{
+			RankAll: &pb.RelatedPlacesInfo_Ranking{Info: infos},
+			RankTop_1000: &pb.RelatedPlacesInfo_Ranking{
+				Info: topWithTies(infos, topN),
+			},
+			RankBottom_1000: &pb.RelatedPlacesInfo_Ranking{
+				Info: bottomWithTies(infos, topN),
+			},
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRankValues(t *testing.T) {
+	values := map[string]float64{
+		"geoId/01": 10,
+		"geoId/02": 30,
+		"geoId/04": 20,
+		"geoId/05": 20,
+		"geoId/06": 5,
+	}
+	infos := rankValues(values)
+	want := []*pb.RelatedPlacesInfo_Ranking_RankInfo{
+		{PlaceDcid: "geoId/02", Value: 30, Rank: 1, Percentile: 90},
+		{PlaceDcid: "geoId/04", Value: 20, Rank: 2, Percentile: 60},
+		{PlaceDcid: "geoId/05", Value: 20, Rank: 2, Percentile: 60},
+		{PlaceDcid: "geoId/01", Value: 10, Rank: 4, Percentile: 30},
+		{PlaceDcid: "geoId/06", Value: 5, Rank: 5, Percentile: 10},
+	}
+	if diff := cmp.Diff(infos, want, protocmp.Transform()); diff != "" {
+		t.Errorf("rankValues() got diff: %v", diff)
+	}
+
+	for _, c := range []struct {
+		n          int
+		wantTop    []string
+		wantBottom []string
+	}{
+		{1, []string{"geoId/02"}, []string{"geoId/06"}},
+		{2, []string{"geoId/02", "geoId/04", "geoId/05"}, []string{"geoId/01", "geoId/06"}},
+		{3, []string{"geoId/02", "geoId/04", "geoId/05"}, []string{"geoId/04", "geoId/05", "geoId/01", "geoId/06"}},
+		{10, []string{"geoId/02", "geoId/04", "geoId/05", "geoId/01", "geoId/06"},
+			[]string{"geoId/02", "geoId/04", "geoId/05", "geoId/01", "geoId/06"}},
+	} {
+		places := func(infos []*pb.RelatedPlacesInfo_Ranking_RankInfo) []string {
+			result := []string{}
+			for _, info := range infos {
+				result = append(result, info.PlaceDcid)
+			}
+			return result
+		}
+		if diff := cmp.Diff(places(topWithTies(infos, c.n)), c.wantTop); diff != "" {
+			t.Errorf("topWithTies(%d) got diff: %v", c.n, diff)
+		}
+		if diff := cmp.Diff(places(bottomWithTies(infos, c.n)), c.wantBottom); diff != "" {
+			t.Errorf("bottomWithTies(%d) got diff: %v", c.n, diff)
+		}
+	}
+}
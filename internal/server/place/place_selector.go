@@ -0,0 +1,106 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"context"
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SingleCollection returns the parent place and child type when the selector
+// selects all the children of a type in a single parent place, so it can be
+// served from the precomputed collection.
+func SingleCollection(selector *pb.PlaceSelector) (string, string, bool) {
+	if len(selector.GetParentPlaces()) != 1 ||
+		len(selector.GetChildTypes()) != 1 ||
+		len(selector.GetPlaces()) > 0 ||
+		len(selector.GetExcludedPlaces()) > 0 ||
+		len(selector.GetExcludedParentPlaces()) > 0 {
+		return "", "", false
+	}
+	return selector.GetParentPlaces()[0], selector.GetChildTypes()[0], true
+}
+
+// childPlacesOf returns the children of all the types in all the parents.
+func childPlacesOf(
+	ctx context.Context, store *store.Store, parents, childTypes []string,
+) ([]string, error) {
+	result := []string{}
+	for _, parent := range parents {
+		for _, childType := range childTypes {
+			children, err := GetChildPlaces(ctx, store, parent, childType)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, children...)
+		}
+	}
+	return result, nil
+}
+
+// ResolvePlaceSelector resolves the selector to a sorted list of places.
+func ResolvePlaceSelector(
+	ctx context.Context, store *store.Store, selector *pb.PlaceSelector,
+) ([]string, error) {
+	if len(selector.GetParentPlaces()) > 0 && len(selector.GetChildTypes()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: place_selector.child_types")
+	}
+	if len(selector.GetParentPlaces()) == 0 && len(selector.GetPlaces()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: place_selector.parent_places or place_selector.places")
+	}
+	children, err := childPlacesOf(
+		ctx, store, selector.GetParentPlaces(), selector.GetChildTypes())
+	if err != nil {
+		return nil, err
+	}
+	excludedChildren, err := childPlacesOf(
+		ctx, store, selector.GetExcludedParentPlaces(), selector.GetChildTypes())
+	if err != nil {
+		return nil, err
+	}
+	return selectPlaces(
+		append(children, selector.GetPlaces()...),
+		append(excludedChildren, selector.GetExcludedPlaces()...),
+	), nil
+}
+
+// selectPlaces returns the sorted unique places that are not excluded.
+func selectPlaces(places, excluded []string) []string {
+	excludedSet := map[string]struct{}{}
+	for _, place := range excluded {
+		excludedSet[place] = struct{}{}
+	}
+	placeSet := map[string]struct{}{}
+	result := []string{}
+	for _, place := range places {
+		if _, ok := excludedSet[place]; ok {
+			continue
+		}
+		if _, ok := placeSet[place]; ok {
+			continue
+		}
+		placeSet[place] = struct{}{}
+		result = append(result, place)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSingleCollection(t *testing.T) {
+	for _, c := range []struct {
+		selector   *pb.PlaceSelector
+		wantParent string
+		wantType   string
+		wantOk     bool
+	}{
+		{
+			&pb.PlaceSelector{ParentPlaces: []string{"geoId/06"}, ChildTypes: []string{"County"}},
+			"geoId/06", "County", true,
+		},
+		{
+			&pb.PlaceSelector{
+				ParentPlaces: []string{"geoId/41", "geoId/53"},
+				ChildTypes:   []string{"County"},
+			},
+			"", "", false,
+		},
+		{
+			&pb.PlaceSelector{
+				ParentPlaces:   []string{"geoId/06"},
+				ChildTypes:     []string{"County"},
+				ExcludedPlaces: []string{"geoId/06085"},
+			},
+			"", "", false,
+		},
+		{
+			&pb.PlaceSelector{Places: []string{"geoId/06085"}},
+			"", "", false,
+		},
+	} {
+		parent, childType, ok := SingleCollection(c.selector)
+		if parent != c.wantParent || childType != c.wantType || ok != c.wantOk {
+			t.Errorf("SingleCollection(%v) = %s, %s, %t, want %s, %s, %t",
+				c.selector, parent, childType, ok, c.wantParent, c.wantType, c.wantOk)
+		}
+	}
+}
+
+func TestSelectPlaces(t *testing.T) {
+	for _, c := range []struct {
+		places   []string
+		excluded []string
+		want     []string
+	}{
+		{
+			[]string{"geoId/53033", "geoId/41051", "geoId/41051"},
+			nil,
+			[]string{"geoId/41051", "geoId/53033"},
+		},
+		{
+			[]string{"geoId/53033", "geoId/41051", "geoId/06085"},
+			[]string{"geoId/41051", "geoId/01001"},
+			[]string{"geoId/06085", "geoId/53033"},
+		},
+		{
+			[]string{"geoId/53033"},
+			[]string{"geoId/53033"},
+			[]string{},
+		},
+	} {
+		got := selectPlaces(c.places, c.excluded)
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("selectPlaces(%v, %v) got diff: %v", c.places, c.excluded, diff)
+		}
+	}
+}
@@ -31,14 +31,24 @@ import (
 func GetPlaceStatDateWithinPlace(
 	ctx context.Context, in *pb.GetPlaceStatDateWithinPlaceRequest, store *store.Store) (
 	*pb.GetPlaceStatDateWithinPlaceResponse, error) {
-	ancestorPlace := in.GetAncestorPlace()
-	placeType := in.GetPlaceType()
+	ancestorPlaces := []string{in.GetAncestorPlace()}
+	placeTypes := []string{in.GetPlaceType()}
 	statVars := in.GetStatVars()
-	if ancestorPlace == "" {
+	if selector := in.GetPlaceSelector(); selector != nil {
+		if len(selector.GetPlaces()) > 0 ||
+			len(selector.GetExcludedPlaces()) > 0 ||
+			len(selector.GetExcludedParentPlaces()) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Only parent_places and child_types are supported in place_selector")
+		}
+		ancestorPlaces = selector.GetParentPlaces()
+		placeTypes = selector.GetChildTypes()
+	}
+	if len(ancestorPlaces) == 0 || ancestorPlaces[0] == "" {
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Missing required argument: ancestorPlace")
 	}
-	if placeType == "" {
+	if len(placeTypes) == 0 || placeTypes[0] == "" {
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Missing required argument: place_place")
 	}
@@ -51,37 +61,43 @@ func GetPlaceStatDateWithinPlace(
 	result := &pb.GetPlaceStatDateWithinPlaceResponse{
 		Data: make(map[string]*pb.DateList),
 	}
-	// Initialize with nil to help check if data is in mem-cache. The nil field
-	// will be populated with empty pb.ObsCollection struct in the end.
-	for _, sv := range statVars {
-		result.Data[sv] = nil
-	}
+	// Dates of all the collections, keyed by stat var.
+	dateSets := map[string]map[string]struct{}{}
+	for _, ancestorPlace := range ancestorPlaces {
+		for _, placeType := range placeTypes {
+			// Construct BigTable row keys.
+			rowList, keyTokens := bigtable.BuildObsCollectionDateFrequencyKey(
+				ancestorPlace, placeType, statVars)
 
-	// Construct BigTable row keys.
-	rowList, keyTokens := bigtable.BuildObsCollectionDateFrequencyKey(
-		ancestorPlace, placeType, statVars)
-
-	cacheData, err := bigtable.ReadStatCollection(ctx, store.BtGroup, rowList, keyTokens)
-	if err != nil {
-		return nil, err
-	}
-	for sv, data := range cacheData {
-		if data != nil {
-			cohorts := data.SourceCohorts
-			sort.Sort(ranking.SeriesByRank(cohorts))
-			dates := []string{}
-			for date := range cohorts[0].Val {
-				dates = append(dates, date)
+			cacheData, err := bigtable.ReadStatCollection(ctx, store.BtGroup, rowList, keyTokens)
+			if err != nil {
+				return nil, err
+			}
+			for sv, data := range cacheData {
+				if data != nil {
+					cohorts := ranking.SortCohorts(
+						data.SourceCohorts, ranking.Options{StatVar: sv, PlaceType: placeType})
+					if len(cohorts) == 0 {
+						continue
+					}
+					if _, ok := dateSets[sv]; !ok {
+						dateSets[sv] = map[string]struct{}{}
+					}
+					for date := range cohorts[0].Val {
+						dateSets[sv][date] = struct{}{}
+					}
+				}
 			}
-			sort.Strings(dates)
-			result.Data[sv] = &pb.DateList{Dates: dates}
 		}
 	}
 
-	for sv := range result.Data {
-		if result.Data[sv] == nil {
-			result.Data[sv] = &pb.DateList{}
+	for _, sv := range statVars {
+		dates := []string{}
+		for date := range dateSets[sv] {
+			dates = append(dates, date)
 		}
+		sort.Strings(dates)
+		result.Data[sv] = &pb.DateList{Dates: dates}
 	}
 	return result, nil
 }
@@ -71,6 +71,14 @@ func GetPlacesIn(ctx context.Context, in *pb.GetPlacesInRequest, store *store.St
 	if !util.CheckValidDCIDs(dcids) {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid DCIDs")
 	}
+	maxDepth := int(in.GetMaxDepth())
+	if maxDepth < 0 || maxDepth > maxPlacesInDepth {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Invalid max_depth: %d, should be within [0, %d]", maxDepth, maxPlacesInDepth)
+	}
+	if maxDepth == 0 {
+		maxDepth = defaultPlacesInDepth
+	}
 
 	rowList := bigtable.BuildPlaceInKey(dcids, placeType)
 
@@ -88,12 +96,25 @@ func GetPlacesIn(ctx context.Context, in *pb.GetPlacesInRequest, store *store.St
 	if err != nil {
 		return nil, err
 	}
-	results := []map[string]string{}
+	results := []map[string]interface{}{}
 	for _, dcid := range dcids {
 		if baseDataMap[dcid] != nil {
 			for _, place := range baseDataMap[dcid].([]string) {
-				results = append(results, map[string]string{"dcid": dcid, "place": place})
+				results = append(results, map[string]interface{}{"dcid": dcid, "place": place})
 			}
+			continue
+		}
+		// No precomputed relation, walk containedInPlace instead.
+		paths, err := walkDescendants(ctx, store, dcid, placeType, maxDepth)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			results = append(results, map[string]interface{}{
+				"dcid":  dcid,
+				"place": path[len(path)-1],
+				"path":  path,
+			})
 		}
 	}
 
@@ -131,6 +152,9 @@ func GetRelatedLocations(ctx context.Context,
 	if !util.CheckValidDCIDs([]string{in.GetDcid()}) {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid DCID")
 	}
+	if in.GetLive() {
+		return getSimilarPlaces(ctx, in, store)
+	}
 
 	sameAncestor := (in.GetWithinPlace() != "")
 	isPerCapita := in.GetIsPerCapita()
@@ -193,6 +217,9 @@ func GetLocationsRankings(ctx context.Context,
 	if in.GetPlaceType() == "" || len(in.GetStatVarDcids()) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "Missing required arguments")
 	}
+	if in.GetLive() {
+		return getLiveRankings(ctx, in, store)
+	}
 
 	isPerCapita := in.GetIsPerCapita()
 	sameAncestor := (in.GetWithinPlace() != "")
@@ -0,0 +1,110 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"context"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/geo"
+	"github.com/datacommonsorg/mixer/internal/server/statvar"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validLatLng returns whether the latitude and longitude are in range.
+func validLatLng(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// GetPlacesInArea implements API for Mixer.GetPlacesInArea.
+func GetPlacesInArea(
+	ctx context.Context, in *pb.GetPlacesInAreaRequest, store *store.Store) (
+	*pb.GetPlacesInAreaResponse, error) {
+	placeType := in.GetPlaceType()
+	if placeType == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: place_type")
+	}
+	index := geo.CurrentIndex()
+	result := []*pb.PlaceInArea{}
+	if bbox := in.GetBbox(); bbox != nil {
+		if !validLatLng(bbox.GetMinLatitude(), bbox.GetMinLongitude()) ||
+			!validLatLng(bbox.GetMaxLatitude(), bbox.GetMaxLongitude()) ||
+			bbox.GetMinLatitude() > bbox.GetMaxLatitude() {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid bbox: %v", bbox)
+		}
+		places := index.IntersectBox(geo.Box{
+			MinLat: bbox.GetMinLatitude(),
+			MinLng: bbox.GetMinLongitude(),
+			MaxLat: bbox.GetMaxLatitude(),
+			MaxLng: bbox.GetMaxLongitude(),
+		}, placeType)
+		for _, p := range places {
+			result = append(result, &pb.PlaceInArea{
+				Dcid:      p.Dcid,
+				Latitude:  p.Latitude,
+				Longitude: p.Longitude,
+			})
+		}
+	} else {
+		if !validLatLng(in.GetLatitude(), in.GetLongitude()) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Invalid latitude and longitude: %v, %v", in.GetLatitude(), in.GetLongitude())
+		}
+		if in.GetRadiusKm() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Missing required argument: radius_km or bbox")
+		}
+		places := index.WithinRadius(
+			in.GetLatitude(), in.GetLongitude(), in.GetRadiusKm(), placeType)
+		for _, p := range places {
+			result = append(result, &pb.PlaceInArea{
+				Dcid:       p.Place.Dcid,
+				Latitude:   p.Place.Latitude,
+				Longitude:  p.Place.Longitude,
+				DistanceKm: p.DistanceKm,
+			})
+		}
+	}
+
+	statVars := in.GetStatVars()
+	if len(statVars) > 0 && len(result) > 0 {
+		dcids := make([]string, len(result))
+		for i, p := range result {
+			dcids[i] = p.Dcid
+		}
+		statVarCount, err := statvar.CountStatVar(ctx, store, statVars, dcids)
+		if err != nil {
+			return nil, err
+		}
+		filtered := []*pb.PlaceInArea{}
+		for _, p := range result {
+			hasData := true
+			for _, sv := range statVars {
+				if _, ok := statVarCount[sv][p.Dcid]; !ok {
+					hasData = false
+					break
+				}
+			}
+			if hasData {
+				filtered = append(filtered, p)
+			}
+		}
+		result = filtered
+	}
+	return &pb.GetPlacesInAreaResponse{Places: result}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"context"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/geo"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// containmentMetadata builds the place metadata from the places containing a
+// coordinate, from the smallest.
+func containmentMetadata(containing []*geo.Place) *pb.PlaceMetadata {
+	info := func(p *geo.Place) *pb.PlaceMetadata_PlaceInfo {
+		placeType := ""
+		if len(p.Types) > 0 {
+			placeType = p.Types[0]
+		}
+		return &pb.PlaceMetadata_PlaceInfo{Dcid: p.Dcid, Name: p.Name, Type: placeType}
+	}
+	result := &pb.PlaceMetadata{Self: info(containing[0])}
+	for _, p := range containing[1:] {
+		result.Parents = append(result.Parents, info(p))
+	}
+	return result
+}
+
+// ResolveCoordinates implements API for Mixer.ResolveCoordinates.
+func ResolveCoordinates(
+	ctx context.Context, in *pb.ResolveCoordinatesRequest, store *store.Store) (
+	*pb.ResolveCoordinatesResponse, error) {
+	coordinates := in.GetCoordinates()
+	if len(coordinates) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: coordinates")
+	}
+	for _, c := range coordinates {
+		if !validLatLng(c.GetLatitude(), c.GetLongitude()) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Invalid latitude and longitude: %v, %v", c.GetLatitude(), c.GetLongitude())
+		}
+	}
+	index := geo.CurrentIndex()
+	containing := make([][]*geo.Place, len(coordinates))
+	selfPlaces := []string{}
+	seen := map[string]bool{}
+	for i, c := range coordinates {
+		containing[i] = index.Containing(c.GetLatitude(), c.GetLongitude())
+		if len(containing[i]) > 0 && !seen[containing[i][0].Dcid] {
+			seen[containing[i][0].Dcid] = true
+			selfPlaces = append(selfPlaces, containing[i][0].Dcid)
+		}
+	}
+	// Prefer the cached place metadata, which has all the ancestors.
+	cached := map[string]*pb.PlaceMetadata{}
+	if len(selfPlaces) > 0 {
+		resp, err := GetPlaceMetadata(ctx, &pb.GetPlaceMetadataRequest{Places: selfPlaces}, store)
+		if err != nil {
+			return nil, err
+		}
+		cached = resp.GetData()
+	}
+	result := &pb.ResolveCoordinatesResponse{}
+	for i, c := range coordinates {
+		placeCoordinate := &pb.ResolveCoordinatesResponse_PlaceCoordinate{
+			Latitude:  c.GetLatitude(),
+			Longitude: c.GetLongitude(),
+		}
+		if len(containing[i]) > 0 {
+			if metadata, ok := cached[containing[i][0].Dcid]; ok {
+				placeCoordinate.Metadata = metadata
+			} else {
+				placeCoordinate.Metadata = containmentMetadata(containing[i])
+			}
+		}
+		result.PlaceCoordinates = append(result.PlaceCoordinates, placeCoordinate)
+	}
+	return result, nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	distanceEuclidean = "euclidean"
+	distanceCosine    = "cosine"
+	// Default number of similar places.
+	defaultSimilarTopN = 5
+)
+
+// zScore normalizes each dimension of the vectors to zero mean and unit
+// standard deviation. Dimensions with no variation become zero.
+func zScore(vectors map[string][]float64, dim int) {
+	n := float64(len(vectors))
+	for d := 0; d < dim; d++ {
+		mean := 0.0
+		for _, v := range vectors {
+			mean += v[d]
+		}
+		mean /= n
+		variance := 0.0
+		for _, v := range vectors {
+			variance += (v[d] - mean) * (v[d] - mean)
+		}
+		std := math.Sqrt(variance / n)
+		for _, v := range vectors {
+			if std == 0 {
+				v[d] = 0
+			} else {
+				v[d] = (v[d] - mean) / std
+			}
+		}
+	}
+}
+
+// vectorDistance computes the euclidean distance or cosine distance (one minus
+// the cosine similarity) of two vectors.
+func vectorDistance(a, b []float64, distance string) float64 {
+	if distance == distanceCosine {
+		dot, normA, normB := 0.0, 0.0, 0.0
+		for i := range a {
+			dot += a[i] * b[i]
+			normA += a[i] * a[i]
+			normB += b[i] * b[i]
+		}
+		if normA == 0 || normB == 0 {
+			return 1
+		}
+		return 1 - dot/math.Sqrt(normA*normB)
+	}
+	sum := 0.0
+	for i := range a {
+		sum += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return math.Sqrt(sum)
+}
+
+// nearestPlaces returns the n places closest to the target place, from the
+// closest. Ties are broken by place dcid.
+func nearestPlaces(
+	vectors map[string][]float64, target string, distance string, n int,
+) []*pb.SimilarPlace {
+	result := []*pb.SimilarPlace{}
+	for place, v := range vectors {
+		if place == target {
+			continue
+		}
+		result = append(result, &pb.SimilarPlace{
+			PlaceDcid: place,
+			Distance:  vectorDistance(vectors[target], v, distance),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Distance != result[j].Distance {
+			return result[i].Distance < result[j].Distance
+		}
+		return result[i].PlaceDcid < result[j].PlaceDcid
+	})
+	if len(result) > n {
+		result = result[:n]
+	}
+	return result
+}
+
+// getSimilarPlaces computes the places closest to a place over a vector of
+// stat vars, from the latest values in the obs collection cache.
+func getSimilarPlaces(
+	ctx context.Context, in *pb.GetRelatedLocationsRequest, store *store.Store,
+) (*pb.GetRelatedLocationsResponse, error) {
+	target := in.GetDcid()
+	distance := in.GetDistance()
+	if distance == "" {
+		distance = distanceEuclidean
+	}
+	if distance != distanceEuclidean && distance != distanceCosine {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid distance: %s", distance)
+	}
+	topN := int(in.GetTopN())
+	if topN <= 0 {
+		topN = defaultSimilarTopN
+	}
+	parentPlace := in.GetWithinPlace()
+	if parentPlace == "" {
+		parentPlace = defaultRankingParent
+	}
+	placeType := in.GetPlaceType()
+	if placeType == "" {
+		resp, err := GetPlaceMetadata(
+			ctx, &pb.GetPlaceMetadataRequest{Places: []string{target}}, store)
+		if err != nil {
+			return nil, err
+		}
+		placeType = resp.GetData()[target].GetSelf().GetType()
+		if placeType == "" {
+			return nil, status.Errorf(codes.NotFound, "No place type for %s", target)
+		}
+	}
+	statVars := in.GetStatVarDcids()
+	cohorts, err := readTopCohorts(ctx, store, parentPlace, placeType, "LATEST", statVars)
+	if err != nil {
+		return nil, err
+	}
+	var population map[string]float64
+	if in.GetIsPerCapita() {
+		population, err = readPopulation(ctx, store, parentPlace, placeType, "LATEST")
+		if err != nil {
+			return nil, err
+		}
+	}
+	values := make([]map[string]float64, len(statVars))
+	for i, statVar := range statVars {
+		cohort, ok := cohorts[statVar]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound,
+				"No data for %s of %s within %s", statVar, placeType, parentPlace)
+		}
+		values[i] = cohort.Val
+		if population != nil {
+			values[i] = perCapita(values[i], population)
+		}
+	}
+	// Only places with data for all the stat vars are candidates.
+	vectors := map[string][]float64{}
+	for place := range values[0] {
+		v := make([]float64, len(statVars))
+		complete := true
+		for i := range statVars {
+			value, ok := values[i][place]
+			if !ok {
+				complete = false
+				break
+			}
+			v[i] = value
+		}
+		if complete {
+			vectors[place] = v
+		}
+	}
+	if _, ok := vectors[target]; !ok {
+		return nil, status.Errorf(codes.NotFound,
+			"No data for %s for all the stat vars", target)
+	}
+	if in.GetNormalize() {
+		zScore(vectors, len(statVars))
+	}
+	dates := func(place string) map[string]string {
+		result := map[string]string{}
+		for _, statVar := range statVars {
+			result[statVar] = cohorts[statVar].PlaceToLatestDate[place]
+		}
+		return result
+	}
+	result := &pb.GetRelatedLocationsResponse{
+		SimilarPlaces: nearestPlaces(vectors, target, distance, topN),
+		Dates:         dates(target),
+	}
+	for _, p := range result.SimilarPlaces {
+		p.Dates = dates(p.PlaceDcid)
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package place
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestNearestPlaces(t *testing.T) {
+	vectors := func() map[string][]float64 {
+		return map[string][]float64{
+			"geoId/01": {1, 100},
+			"geoId/02": {2, 100},
+			"geoId/04": {1, 300},
+			"geoId/05": {10, 1000},
+		}
+	}
+	for _, c := range []struct {
+		distance  string
+		normalize bool
+		want      []*pb.SimilarPlace
+	}{
+		{
+			distanceEuclidean,
+			false,
+			[]*pb.SimilarPlace{
+				{PlaceDcid: "geoId/02", Distance: 1},
+				{PlaceDcid: "geoId/04", Distance: 200},
+			},
+		},
+		{
+			// Both dimensions have the same weight after z-score.
+			distanceEuclidean,
+			true,
+			[]*pb.SimilarPlace{
+				{PlaceDcid: "geoId/02", Distance: 0.26490647141300877},
+				{PlaceDcid: "geoId/04", Distance: 0.5405899027195888},
+			},
+		},
+		{
+			distanceCosine,
+			false,
+			[]*pb.SimilarPlace{
+				// Proportional to the target.
+				{PlaceDcid: "geoId/05", Distance: 0},
+				{PlaceDcid: "geoId/04", Distance: 2.222000020035697e-05},
+			},
+		},
+	} {
+		v := vectors()
+		if c.normalize {
+			zScore(v, 2)
+		}
+		got := nearestPlaces(v, "geoId/01", c.distance, 2)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform(),
+			cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+			t.Errorf("nearestPlaces(%s, %v) got diff: %v", c.distance, c.normalize, diff)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package placeindex is an in-memory index of place names.
+package placeindex
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Place is a place in the index.
+type Place struct {
+	Dcid  string
+	Name  string
+	Types []string
+	// Dcids of the ancestor places.
+	ContainedIn []string
+	Population  float64
+}
+
+// HasType returns whether the place is of the type.
+func (p *Place) HasType(placeType string) bool {
+	for _, t := range p.Types {
+		if t == placeType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsContainedIn returns whether the place is in the ancestor place.
+func (p *Place) IsContainedIn(ancestor string) bool {
+	for _, a := range p.ContainedIn {
+		if a == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// Number of places kept at each trie node for prefix completion.
+const maxPrefixPlaces = 500
+
+// trieNode is a node of the trie of name tokens.
+type trieNode struct {
+	children map[rune]*trieNode
+	// Dcids of the places with a name token ending at this node.
+	dcids map[string]struct{}
+	// Dcids of the most populous places with a name token starting with the
+	// prefix of this node, at most maxPrefixPlaces.
+	top []string
+}
+
+// Index is an index of place names, with a trie of the name tokens.
+type Index struct {
+	places map[string]*Place
+	// Types of the places.
+	types map[string]struct{}
+	// Number of tokens of the name of each place.
+	numTokens map[string]int
+	root      *trieNode
+}
+
+// tokenize splits a name into lower case tokens of letters and digits.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// NewIndex builds an index of the places.
+func NewIndex(places []*Place) *Index {
+	index := &Index{
+		places:    map[string]*Place{},
+		types:     map[string]struct{}{},
+		numTokens: map[string]int{},
+		root:      &trieNode{},
+	}
+	for _, p := range places {
+		index.places[p.Dcid] = p
+		for _, t := range p.Types {
+			index.types[t] = struct{}{}
+		}
+		tokens := tokenize(p.Name)
+		index.numTokens[p.Dcid] = len(tokens)
+		for _, token := range tokens {
+			node := index.root
+			for _, c := range token {
+				if node.children == nil {
+					node.children = map[rune]*trieNode{}
+				}
+				if _, ok := node.children[c]; !ok {
+					node.children[c] = &trieNode{}
+				}
+				node = node.children[c]
+			}
+			if node.dcids == nil {
+				node.dcids = map[string]struct{}{}
+			}
+			node.dcids[p.Dcid] = struct{}{}
+		}
+	}
+	index.collectTop(index.root)
+	return index
+}
+
+// less ranks the places by population, for prefix completion.
+func (index *Index) less(a, b string) bool {
+	pa, pb := index.places[a].Population, index.places[b].Population
+	if pa != pb {
+		return pa > pb
+	}
+	return a < b
+}
+
+// collectTop sets the most populous places below each node, merging the ones
+// of the children.
+func (index *Index) collectTop(node *trieNode) {
+	seen := map[string]struct{}{}
+	top := []string{}
+	add := func(dcid string) {
+		if _, ok := seen[dcid]; !ok {
+			seen[dcid] = struct{}{}
+			top = append(top, dcid)
+		}
+	}
+	for dcid := range node.dcids {
+		add(dcid)
+	}
+	for _, child := range node.children {
+		index.collectTop(child)
+		for _, dcid := range child.top {
+			add(dcid)
+		}
+	}
+	sort.Slice(top, func(i, j int) bool { return index.less(top[i], top[j]) })
+	if len(top) > maxPrefixPlaces {
+		top = top[:maxPrefixPlaces]
+	}
+	node.top = top
+}
+
+// Place returns the place of a dcid, or nil when it is not in the index.
+func (index *Index) Place(dcid string) *Place {
+	return index.places[dcid]
+}
+
+// maxEdits is the number of typos tolerated in a token of the length.
+func maxEdits(token []rune) int {
+	switch {
+	case len(token) <= 3:
+		return 0
+	case len(token) <= 7:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// fuzzyToken returns the places with a name token within the edit distance of
+// the token, with the smallest edit distance of each place. The trie is
+// walked with a row of the Levenshtein distance matrix per node.
+func (index *Index) fuzzyToken(token []rune, edits int) map[string]int {
+	result := map[string]int{}
+	row := make([]int, len(token)+1)
+	for i := range row {
+		row[i] = i
+	}
+	var walk func(node *trieNode, prev []int)
+	walk = func(node *trieNode, prev []int) {
+		if d := prev[len(token)]; d <= edits {
+			for dcid := range node.dcids {
+				if old, ok := result[dcid]; !ok || d < old {
+					result[dcid] = d
+				}
+			}
+		}
+		for c, child := range node.children {
+			curr := make([]int, len(token)+1)
+			curr[0] = prev[0] + 1
+			best := curr[0]
+			for i := 1; i <= len(token); i++ {
+				cost := 1
+				if token[i-1] == c {
+					cost = 0
+				}
+				curr[i] = minInt(curr[i-1]+1, prev[i]+1, prev[i-1]+cost)
+				if curr[i] < best {
+					best = curr[i]
+				}
+			}
+			// No token below the child is within the edit distance.
+			if best <= edits {
+				walk(child, curr)
+			}
+		}
+	}
+	walk(index.root, row)
+	return result
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+	return result
+}
+
+// Match is a place matching a name, with the confidence in [0, 1].
+type Match struct {
+	Place      *Place
+	Confidence float64
+}
+
+// MatchName returns the places whose name has all the tokens of the name, each
+// within a few typos, from the most confident. placeType and containedIn
+// filter the places when not empty.
+//
+// The confidence is the share of the name characters without typos times the
+// share of the place name tokens that are matched, so an exact match has
+// confidence 1.
+func (index *Index) MatchName(name, placeType, containedIn string) []*Match {
+	tokens := tokenize(name)
+	if len(tokens) == 0 {
+		return []*Match{}
+	}
+	numChars := 0
+	totalEdits := map[string]int{}
+	for i, token := range tokens {
+		runes := []rune(token)
+		numChars += len(runes)
+		matches := index.fuzzyToken(runes, maxEdits(runes))
+		if i == 0 {
+			totalEdits = matches
+			continue
+		}
+		for dcid, edits := range totalEdits {
+			if d, ok := matches[dcid]; ok {
+				totalEdits[dcid] = edits + d
+			} else {
+				delete(totalEdits, dcid)
+			}
+		}
+	}
+	result := []*Match{}
+	for dcid, edits := range totalEdits {
+		p := index.places[dcid]
+		if placeType != "" && !p.HasType(placeType) {
+			continue
+		}
+		if containedIn != "" && !p.IsContainedIn(containedIn) {
+			continue
+		}
+		coverage := float64(len(tokens)) / float64(index.numTokens[dcid])
+		if coverage > 1 {
+			coverage = 1
+		}
+		result = append(result, &Match{
+			Place:      p,
+			Confidence: (1 - float64(edits)/float64(numChars)) * coverage,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Confidence != result[j].Confidence {
+			return result[i].Confidence > result[j].Confidence
+		}
+		if result[i].Place.Population != result[j].Place.Population {
+			return result[i].Place.Population > result[j].Place.Population
+		}
+		return result[i].Place.Dcid < result[j].Place.Dcid
+	})
+	return result
+}
+
+var (
+	indexLock    sync.RWMutex
+	currentIndex = NewIndex(nil)
+)
+
+// SetIndex replaces the index used by the APIs.
+func SetIndex(index *Index) {
+	indexLock.Lock()
+	defer indexLock.Unlock()
+	currentIndex = index
+}
+
+// CurrentIndex returns the index used by the APIs.
+func CurrentIndex() *Index {
+	indexLock.RLock()
+	defer indexLock.RUnlock()
+	return currentIndex
+}
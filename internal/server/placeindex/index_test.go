@@ -0,0 +1,82 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placeindex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+const places = `dcid,name,typeOf,containedInPlace,population
+geoId/0668000,San Jose,City,geoId/06;country/USA,1021795
+wikidataId/Q3070,San José,City,country/CRI,288054
+geoId/06085,Santa Clara County,County,geoId/06;country/USA,1927852
+geoId/0669084,Santa Clara,City,geoId/06085;geoId/06;country/USA,
+`
+
+func TestMatchName(t *testing.T) {
+	parsed, err := ParsePlaces(strings.NewReader(places))
+	if err != nil {
+		t.Fatalf("ParsePlaces() got error %v", err)
+	}
+	if len(parsed) != 4 {
+		t.Fatalf("ParsePlaces() got %d places, want 4", len(parsed))
+	}
+	index := NewIndex(parsed)
+
+	type match struct {
+		Dcid       string
+		Confidence float64
+	}
+	for _, c := range []struct {
+		name        string
+		placeType   string
+		containedIn string
+		want        []match
+	}{
+		{
+			"San Jose", "", "",
+			[]match{{"geoId/0668000", 1}, {"wikidataId/Q3070", 1 - 1.0/7}},
+		},
+		{
+			"Sann Jose", "", "country/USA",
+			[]match{{"geoId/0668000", 1 - 1.0/8}},
+		},
+		{
+			"santa clara", "", "",
+			[]match{{"geoId/0669084", 1}, {"geoId/06085", 2.0 / 3}},
+		},
+		{
+			"Santa Clara", "County", "",
+			[]match{{"geoId/06085", 2.0 / 3}},
+		},
+		{
+			"Paris", "", "",
+			[]match{},
+		},
+	} {
+		got := []match{}
+		for _, m := range index.MatchName(c.name, c.placeType, c.containedIn) {
+			got = append(got, match{m.Place.Dcid, m.Confidence})
+		}
+		if diff := cmp.Diff(got, c.want, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+			t.Errorf("MatchName(%s, %s, %s) got diff: %v",
+				c.name, c.placeType, c.containedIn, diff)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placeindex
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// splitList splits a ";" separated list.
+func splitList(s string) []string {
+	result := []string{}
+	for _, item := range strings.Split(s, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// ParsePlaces parses places from a CSV export with the dcid, name, typeOf,
+// containedInPlace and population columns. The typeOf and containedInPlace
+// columns are ";" separated lists, and population can be empty.
+func ParsePlaces(r io.Reader) ([]*Place, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 5
+	result := []*Place{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if record[0] == "dcid" {
+			// Header.
+			continue
+		}
+		p := &Place{
+			Dcid:        record[0],
+			Name:        record[1],
+			Types:       splitList(record[2]),
+			ContainedIn: splitList(record[3]),
+		}
+		if record[4] != "" {
+			if p.Population, err = strconv.ParseFloat(record[4], 64); err != nil {
+				return nil, fmt.Errorf("invalid population of %s: %v", p.Dcid, err)
+			}
+		}
+		result = append(result, p)
+	}
+}
+
+// LoadIndex builds the index used by the APIs from a CSV export of places.
+func LoadIndex(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	places, err := ParsePlaces(f)
+	if err != nil {
+		return err
+	}
+	SetIndex(NewIndex(places))
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placeindex
+
+import (
+	"sort"
+	"unicode"
+)
+
+// Len returns the number of places in the index.
+func (index *Index) Len() int {
+	return len(index.places)
+}
+
+// Types returns the sorted types of the places in the index.
+func (index *Index) Types() []string {
+	result := make([]string, 0, len(index.types))
+	for t := range index.types {
+		result = append(result, t)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// prefixToken returns the most populous places with a name token starting
+// with the token, at most maxPrefixPlaces, so a short prefix does not visit
+// all the places.
+func (index *Index) prefixToken(token []rune) map[string]int {
+	result := map[string]int{}
+	node := index.root
+	for _, c := range token {
+		child, ok := node.children[c]
+		if !ok {
+			return result
+		}
+		node = child
+	}
+	for _, dcid := range node.top {
+		result[dcid] = 0
+	}
+	return result
+}
+
+// Search returns the places whose name has all the tokens of the query, from
+// the fewest typos and then the largest population. Unless the query ends
+// with a separator, the last token is also matched as a prefix for
+// autocomplete, among the most populous places with the prefix. types filters
+// the places when not empty.
+func (index *Index) Search(query string, types []string) []*Place {
+	tokens := tokenize(query)
+	if len(tokens) == 0 {
+		return []*Place{}
+	}
+	runes := []rune(query)
+	last := runes[len(runes)-1]
+	isPrefix := unicode.IsLetter(last) || unicode.IsDigit(last)
+
+	var totalEdits map[string]int
+	for i, token := range tokens {
+		runes := []rune(token)
+		matches := index.fuzzyToken(runes, maxEdits(runes))
+		if isPrefix && i == len(tokens)-1 {
+			for dcid := range index.prefixToken(runes) {
+				matches[dcid] = 0
+			}
+		}
+		if i == 0 {
+			totalEdits = matches
+			continue
+		}
+		for dcid, edits := range totalEdits {
+			if d, ok := matches[dcid]; ok {
+				totalEdits[dcid] = edits + d
+			} else {
+				delete(totalEdits, dcid)
+			}
+		}
+	}
+	result := []*Place{}
+	for dcid := range totalEdits {
+		p := index.places[dcid]
+		if len(types) > 0 {
+			hasType := false
+			for _, t := range types {
+				if p.HasType(t) {
+					hasType = true
+					break
+				}
+			}
+			if !hasType {
+				continue
+			}
+		}
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		ei, ej := totalEdits[result[i].Dcid], totalEdits[result[j].Dcid]
+		if ei != ej {
+			return ei < ej
+		}
+		return index.less(result[i].Dcid, result[j].Dcid)
+	})
+	return result
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placeindex
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSearch(t *testing.T) {
+	parsed, err := ParsePlaces(strings.NewReader(places))
+	if err != nil {
+		t.Fatalf("ParsePlaces() got error %v", err)
+	}
+	index := NewIndex(parsed)
+
+	for _, c := range []struct {
+		query string
+		types []string
+		want  []string
+	}{
+		{
+			"san", nil,
+			[]string{"geoId/06085", "geoId/0668000", "wikidataId/Q3070", "geoId/0669084"},
+		},
+		// Complete token.
+		{
+			"san ", nil,
+			[]string{"geoId/0668000", "wikidataId/Q3070"},
+		},
+		{
+			"santa clara", []string{"City"},
+			[]string{"geoId/0669084"},
+		},
+		// Typos.
+		{
+			"sann jose", nil,
+			[]string{"geoId/0668000", "wikidataId/Q3070"},
+		},
+		{
+			"", nil,
+			[]string{},
+		},
+	} {
+		got := []string{}
+		for _, p := range index.Search(c.query, c.types) {
+			got = append(got, p.Dcid)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("Search(%q, %v) got diff: %v", c.query, c.types, diff)
+		}
+	}
+}
+
+func TestSearchPrefixLimit(t *testing.T) {
+	places := []*Place{}
+	for i := 0; i < maxPrefixPlaces+10; i++ {
+		places = append(places, &Place{
+			Dcid:       fmt.Sprintf("dc/%d", i),
+			Name:       fmt.Sprintf("Place %d", i),
+			Population: float64(i),
+		})
+	}
+	got := NewIndex(places).Search("pl", nil)
+	if len(got) != maxPrefixPlaces {
+		t.Fatalf("Search() got %d places, want %d", len(got), maxPrefixPlaces)
+	}
+	want := fmt.Sprintf("dc/%d", maxPrefixPlaces+9)
+	if got[0].Dcid != want {
+		t.Errorf("Search() got first place %s, want %s", got[0].Dcid, want)
+	}
+}
+
+func TestTypes(t *testing.T) {
+	parsed, err := ParsePlaces(strings.NewReader(places))
+	if err != nil {
+		t.Fatalf("ParsePlaces() got error %v", err)
+	}
+	if diff := cmp.Diff(NewIndex(parsed).Types(), []string{"City", "County"}); diff != "" {
+		t.Errorf("Types() got diff %v", diff)
+	}
+}
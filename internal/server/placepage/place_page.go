@@ -30,6 +30,7 @@ import (
 	"github.com/datacommonsorg/mixer/internal/server/model"
 	"github.com/datacommonsorg/mixer/internal/server/node"
 	"github.com/datacommonsorg/mixer/internal/server/place"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
 	"github.com/datacommonsorg/mixer/internal/server/stat"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
@@ -267,10 +268,11 @@ func fetchBtData(
 		placePageData := data.(*pb.StatVarObsSeries)
 		finalData := &pb.StatVarSeries{Data: map[string]*pb.Series{}}
 		for statVar, obsTimeSeries := range placePageData.Data {
-			series, _ := stat.GetBestSeries(obsTimeSeries, "", false /* useLatest */)
+			opts := ranking.Options{StatVar: statVar}
+			series, _ := stat.GetBestSeries(obsTimeSeries, "", false /* useLatest */, opts)
 			finalData.Data[statVar] = series
 			if statVar == "Count_Person" {
-				popSeries, latestDate := stat.GetBestSeries(obsTimeSeries, "", true /* useLatest */)
+				popSeries, latestDate := stat.GetBestSeries(obsTimeSeries, "", true /* useLatest */, opts)
 				if popSeries != nil {
 					if conversion, ok := convert.UnitMapping[popSeries.Metadata.Unit]; ok {
 						popSeries.Metadata.Unit = conversion.Unit
@@ -0,0 +1,281 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranking
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/model"
+)
+
+// Rule is a ranking rule of the ranking config file.
+//
+// MeasurementMethod and ObservationPeriod default to the "*" wildcard when
+// omitted. A rule with StatVar or PlaceType only applies to the series of the
+// stat var or of places of the type, and overrides the rules for all stat vars
+// and place types.
+type Rule struct {
+	ImportName        string  `json:"importName"`
+	MeasurementMethod *string `json:"measurementMethod,omitempty"`
+	ObservationPeriod *string `json:"observationPeriod,omitempty"`
+	StatVar           string  `json:"statVar,omitempty"`
+	PlaceType         string  `json:"placeType,omitempty"`
+	Rank              int     `json:"rank"`
+}
+
+// Config is the ranking config file, which replaces StatsRanking when loaded.
+type Config struct {
+	Rules []*Rule `json:"rules"`
+}
+
+// scope is the stat var and place type a set of ranking rules applies to.
+// Empty fields apply to all stat vars or place types.
+type scope struct {
+	statVar   string
+	placeType string
+}
+
+var (
+	rulesLock sync.RWMutex
+	rules     = map[scope]map[RankKey]int{{}: StatsRanking}
+)
+
+// Options holds the context of ranking the source series.
+type Options struct {
+	// Dcid of the stat var of the series.
+	StatVar string
+	// Type of the place of the series, or of the places in a cohort.
+	PlaceType string
+	// Import names ranked above the ranking rules, from the most preferred.
+	PreferredImports []string
+	// Import names excluded from the sources.
+	ExcludedImports []string
+}
+
+// preferredRank is the score of the most preferred import, lower than any
+// ranking rule.
+const preferredRank = math.MinInt32
+
+// parseConfig parses a ranking config in JSON into ranking rules.
+func parseConfig(data []byte) (map[scope]map[RankKey]int, error) {
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	result := map[scope]map[RankKey]int{}
+	for _, rule := range config.Rules {
+		if rule.ImportName == "" {
+			return nil, fmt.Errorf("missing importName in ranking rule")
+		}
+		key := RankKey{
+			ImportName:        rule.ImportName,
+			MeasurementMethod: "*",
+			ObservationPeriod: "*",
+		}
+		if rule.MeasurementMethod != nil {
+			key.MeasurementMethod = *rule.MeasurementMethod
+		}
+		if rule.ObservationPeriod != nil {
+			key.ObservationPeriod = *rule.ObservationPeriod
+		}
+		s := scope{rule.StatVar, rule.PlaceType}
+		if _, ok := result[s]; !ok {
+			result[s] = map[RankKey]int{}
+		}
+		result[s][key] = rule.Rank
+	}
+	return result, nil
+}
+
+// LoadConfig replaces the ranking rules with a ranking config in JSON. The
+// current rules are kept when the config is invalid.
+func LoadConfig(data []byte) error {
+	newRules, err := parseConfig(data)
+	if err != nil {
+		return err
+	}
+	rulesLock.Lock()
+	defer rulesLock.Unlock()
+	rules = newRules
+	return nil
+}
+
+// RankedPlaceType returns the first of the place types that has ranking rules,
+// or "" when there is none.
+func RankedPlaceType(placeTypes []string) string {
+	rulesLock.RLock()
+	defer rulesLock.RUnlock()
+	for _, placeType := range placeTypes {
+		for s := range rules {
+			if s.placeType != "" && s.placeType == placeType {
+				return placeType
+			}
+		}
+	}
+	return ""
+}
+
+// HasPlaceTypeRules returns whether any ranking rule depends on place type.
+func HasPlaceTypeRules() bool {
+	rulesLock.RLock()
+	defer rulesLock.RUnlock()
+	for s := range rules {
+		if s.placeType != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// score derives the ranking score for a source series.
+//
+// The rules of the most specific scope are checked first: the stat var and
+// place type, the stat var, the place type, and then all. Within a scope,
+// exact match of the properties is preferred over wildcard options (indicated
+// by *).
+//
+// Preferred imports of the options are ranked above all the rules.
+//
+// If no entry is found, a BaseRank is assigned to the source series.
+func (o Options) score(importName, mmethod, operiod string) int {
+	for i, preferred := range o.PreferredImports {
+		if preferred == importName {
+			return preferredRank + i
+		}
+	}
+	rulesLock.RLock()
+	defer rulesLock.RUnlock()
+	scopes := []scope{}
+	if o.StatVar != "" && o.PlaceType != "" {
+		scopes = append(scopes, scope{o.StatVar, o.PlaceType})
+	}
+	if o.StatVar != "" {
+		scopes = append(scopes, scope{statVar: o.StatVar})
+	}
+	if o.PlaceType != "" {
+		scopes = append(scopes, scope{placeType: o.PlaceType})
+	}
+	scopes = append(scopes, scope{})
+	for _, s := range scopes {
+		scopeRules, ok := rules[s]
+		if !ok {
+			continue
+		}
+		for _, propCombination := range []struct {
+			mm string
+			op string
+		}{
+			// Check exact match first
+			{mmethod, operiod},
+			{mmethod, "*"},
+			{"*", operiod},
+			{"*", "*"},
+		} {
+			key := RankKey{
+				ImportName:        importName,
+				MeasurementMethod: propCombination.mm,
+				ObservationPeriod: propCombination.op,
+			}
+			if score, ok := scopeRules[key]; ok {
+				return score
+			}
+		}
+	}
+	return BaseRank
+}
+
+func (o Options) scorePb(s *pb.SourceSeries) int {
+	return o.score(s.ImportName, s.MeasurementMethod, s.ObservationPeriod)
+}
+
+// excluded returns whether the import is excluded by the options.
+func (o Options) excluded(importName string) bool {
+	for _, excluded := range o.ExcludedImports {
+		if excluded == importName {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutExcludedPb returns the series not from the excluded imports. The
+// input is returned when there is no exclusion.
+func (o Options) withoutExcludedPb(series []*pb.SourceSeries) []*pb.SourceSeries {
+	if len(o.ExcludedImports) == 0 {
+		return series
+	}
+	result := []*pb.SourceSeries{}
+	for _, s := range series {
+		if !o.excluded(s.ImportName) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// SortSeries returns the source series of a place without the excluded
+// imports, sorted from the best ranked.
+func SortSeries(series []*pb.SourceSeries, opts Options) []*pb.SourceSeries {
+	series = opts.withoutExcludedPb(series)
+	scores := make(map[*pb.SourceSeries]int, len(series))
+	for _, s := range series {
+		scores[s] = opts.scorePb(s)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return lessSeriesPb(series[i], series[j], scores[series[i]], scores[series[j]])
+	})
+	return series
+}
+
+// SortCohorts returns the source cohorts of a stat var without the excluded
+// imports, sorted from the best ranked.
+func SortCohorts(cohorts []*pb.SourceSeries, opts Options) []*pb.SourceSeries {
+	cohorts = opts.withoutExcludedPb(cohorts)
+	scores := make(map[*pb.SourceSeries]int, len(cohorts))
+	for _, s := range cohorts {
+		scores[s] = opts.scorePb(s)
+	}
+	sort.Slice(cohorts, func(i, j int) bool {
+		return lessCohortPb(cohorts[i], cohorts[j], scores[cohorts[i]], scores[cohorts[j]])
+	})
+	return cohorts
+}
+
+// Sort returns the source series of a place without the excluded imports,
+// sorted from the best ranked.
+func Sort(series []*model.SourceSeries, opts Options) []*model.SourceSeries {
+	if len(opts.ExcludedImports) > 0 {
+		kept := []*model.SourceSeries{}
+		for _, s := range series {
+			if !opts.excluded(s.ImportName) {
+				kept = append(kept, s)
+			}
+		}
+		series = kept
+	}
+	scores := make(map[*model.SourceSeries]int, len(series))
+	for _, s := range series {
+		scores[s] = opts.score(s.ImportName, s.MeasurementMethod, s.ObservationPeriod)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return lessSeries(series[i], series[j], scores[series[i]], scores[series[j]])
+	})
+	return series
+}
@@ -0,0 +1,178 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranking
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestLoadConfig(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/ranking.json")
+	if err != nil {
+		t.Fatalf("ReadFile() got error %v", err)
+	}
+	defer func() {
+		rules = map[scope]map[RankKey]int{{}: StatsRanking}
+	}()
+	if err := LoadConfig(data); err != nil {
+		t.Fatalf("LoadConfig() got error %v", err)
+	}
+	if !HasPlaceTypeRules() {
+		t.Errorf("HasPlaceTypeRules() = false, want true")
+	}
+	if got := RankedPlaceType([]string{"AdministrativeArea2", "County"}); got != "County" {
+		t.Errorf("RankedPlaceType() = %s, want County", got)
+	}
+
+	for _, c := range []struct {
+		series *pb.SourceSeries
+		opts   Options
+		want   int
+	}{
+		{&pb.SourceSeries{ImportName: "CensusPEP", MeasurementMethod: "CensusPEPSurvey"}, Options{}, 0},
+		{&pb.SourceSeries{ImportName: "EurostatData"}, Options{}, 2},
+		{&pb.SourceSeries{ImportName: "EurostatData", MeasurementMethod: "MM"}, Options{}, BaseRank},
+		// Rules not in the config are dropped.
+		{&pb.SourceSeries{ImportName: "WorldDevelopmentIndicators"}, Options{}, BaseRank},
+		{
+			&pb.SourceSeries{ImportName: "CensusACS5YearSurvey", MeasurementMethod: "CensusACS5yrSurvey"},
+			Options{StatVar: "Median_Income_Person"},
+			0,
+		},
+		{
+			&pb.SourceSeries{ImportName: "CensusACS5YearSurvey", MeasurementMethod: "CensusACS5yrSurvey"},
+			Options{StatVar: "Count_Person"},
+			1,
+		},
+		{&pb.SourceSeries{ImportName: "BLS_LAUS"}, Options{PlaceType: "County"}, 0},
+		{&pb.SourceSeries{ImportName: "BLS_LAUS"}, Options{PlaceType: "State"}, BaseRank},
+		{
+			&pb.SourceSeries{ImportName: "BLS_LAUS"},
+			Options{StatVar: "UnemploymentRate_Person", PlaceType: "State"},
+			200,
+		},
+	} {
+		if got := c.opts.scorePb(c.series); got != c.want {
+			t.Errorf("scorePb(%s, %+v) = %d, want %d", c.series.ImportName, c.opts, got, c.want)
+		}
+	}
+
+	series := []*pb.SourceSeries{
+		{ImportName: "CensusPEP", MeasurementMethod: "CensusPEPSurvey"},
+		{ImportName: "CensusACS5YearSurvey", MeasurementMethod: "CensusACS5yrSurvey"},
+	}
+	SortSeries(series, Options{StatVar: "Median_Income_Person"})
+	want := []*pb.SourceSeries{
+		{ImportName: "CensusACS5YearSurvey", MeasurementMethod: "CensusACS5yrSurvey"},
+		{ImportName: "CensusPEP", MeasurementMethod: "CensusPEPSurvey"},
+	}
+	if diff := cmp.Diff(series, want, protocmp.Transform()); diff != "" {
+		t.Errorf("SortSeries() got diff: %v", diff)
+	}
+
+	if err := LoadConfig([]byte(`{"rules": [{"rank": 1}]}`)); err == nil {
+		t.Errorf("LoadConfig() should fail on missing importName")
+	}
+}
+
+func TestSortSeriesWithImports(t *testing.T) {
+	series := []*pb.SourceSeries{
+		{ImportName: "CensusPEP", MeasurementMethod: "CensusPEPSurvey"},
+		{ImportName: "CensusACS5YearSurvey", MeasurementMethod: "CensusACS5yrSurvey"},
+		{ImportName: "WorldDevelopmentIndicators"},
+		{ImportName: "WikidataPopulation", MeasurementMethod: "WikidataPopulation"},
+	}
+	for _, c := range []struct {
+		opts Options
+		want []string
+	}{
+		{
+			Options{},
+			[]string{"CensusPEP", "CensusACS5YearSurvey", "WorldDevelopmentIndicators", "WikidataPopulation"},
+		},
+		{
+			Options{PreferredImports: []string{"WikidataPopulation", "CensusACS5YearSurvey"}},
+			[]string{"WikidataPopulation", "CensusACS5YearSurvey", "CensusPEP", "WorldDevelopmentIndicators"},
+		},
+		{
+			Options{
+				PreferredImports: []string{"CensusACS5YearSurvey"},
+				ExcludedImports:  []string{"CensusPEP", "WikidataPopulation"},
+			},
+			[]string{"CensusACS5YearSurvey", "WorldDevelopmentIndicators"},
+		},
+	} {
+		in := append([]*pb.SourceSeries{}, series...)
+		got := []string{}
+		for _, s := range SortSeries(in, c.opts) {
+			got = append(got, s.ImportName)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("SortSeries(%+v) got diff: %v", c.opts, diff)
+		}
+	}
+}
+
+func TestSortCohortsWithImports(t *testing.T) {
+	vals := func(n int) map[string]float64 {
+		result := map[string]float64{}
+		for i := 0; i < n; i++ {
+			result[fmt.Sprintf("geoId/%02d", i)] = 1
+		}
+		return result
+	}
+	cohorts := []*pb.SourceSeries{
+		{ImportName: "CensusPEP", MeasurementMethod: "CensusPEPSurvey", Val: vals(2)},
+		{ImportName: "CensusACS5YearSurvey", MeasurementMethod: "CensusACS5yrSurvey", Val: vals(3)},
+		{ImportName: "ImportA", Val: vals(4)},
+		{ImportName: "ImportB", Val: vals(5)},
+	}
+	for _, c := range []struct {
+		opts Options
+		want []string
+	}{
+		// Unranked cohorts are sorted by the number of places.
+		{
+			Options{},
+			[]string{"CensusPEP", "CensusACS5YearSurvey", "ImportB", "ImportA"},
+		},
+		{
+			Options{PreferredImports: []string{"ImportA", "CensusACS5YearSurvey"}},
+			[]string{"ImportA", "CensusACS5YearSurvey", "CensusPEP", "ImportB"},
+		},
+		{
+			Options{
+				PreferredImports: []string{"CensusACS5YearSurvey"},
+				ExcludedImports:  []string{"CensusPEP", "ImportB"},
+			},
+			[]string{"CensusACS5YearSurvey", "ImportA"},
+		},
+	} {
+		in := append([]*pb.SourceSeries{}, cohorts...)
+		got := []string{}
+		for _, s := range SortCohorts(in, c.opts) {
+			got = append(got, s.ImportName)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("SortCohorts(%+v) got diff: %v", c.opts, diff)
+		}
+	}
+}
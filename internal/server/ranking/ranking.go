@@ -82,40 +82,10 @@ const BaseRank = 100
 // cohort instead of time series.
 type CohortByRank []*pb.SourceSeries
 
-// getScorePb derives the ranking score for a source series.
-//
-// The score depends on ImportName and other SVObs properties, by checking the
-// StatsRanking dict. To get the score, ImportName is required, with optional
-// properties:
-// - MeasurementMethod
-// - ObservationPeriod
-//
-// When there are exact match of the properties in StatsRanking, then use that
-// score, otherwise can also match to wildcard options (indicated by *).
-//
-// If no entry is found, a BaseRank is assigned to the source series.
+// getScorePb derives the ranking score for a source series with the ranking
+// rules of all stat vars and place types.
 func getScorePb(s *pb.SourceSeries) int {
-	for _, propCombination := range []struct {
-		mm string
-		op string
-	}{
-		// Check exact match first
-		{s.MeasurementMethod, s.ObservationPeriod},
-		{s.MeasurementMethod, "*"},
-		{"*", s.ObservationPeriod},
-		{"*", "*"},
-	} {
-		key := RankKey{
-			ImportName:        s.ImportName,
-			MeasurementMethod: propCombination.mm,
-			ObservationPeriod: propCombination.op,
-		}
-		score, ok := StatsRanking[key]
-		if ok {
-			return score
-		}
-	}
-	return BaseRank
+	return Options{}.scorePb(s)
 }
 
 func (a CohortByRank) Len() int {
@@ -127,17 +97,17 @@ func (a CohortByRank) Swap(i, j int) {
 }
 
 func (a CohortByRank) Less(i, j int) bool {
-	oi := a[i]
-	scorei := getScorePb(oi)
-	oj := a[j]
-	scorej := getScorePb(oj)
+	return lessCohortPb(a[i], a[j], getScorePb(a[i]), getScorePb(a[j]))
+}
+
+func lessCohortPb(oi, oj *pb.SourceSeries, scorei, scorej int) bool {
 	// Higher score value means lower rank.
 	if scorei != scorej {
 		return scorei < scorej
 	}
 	// Cohort with more place coverage is ranked higher
-	if len(a[i].Val) != len(a[j].Val) {
-		return len(a[i].Val) > len(a[j].Val)
+	if len(oi.Val) != len(oj.Val) {
+		return len(oi.Val) > len(oj.Val)
 	}
 
 	// Compare other fields to get consistent ranking.
@@ -170,10 +140,10 @@ func (a SeriesByRank) Len() int { return len(a) }
 func (a SeriesByRank) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 
 func (a SeriesByRank) Less(i, j int) bool {
-	oi := a[i]
-	scorei := getScorePb(oi)
-	oj := a[j]
-	scorej := getScorePb(oj)
+	return lessSeriesPb(a[i], a[j], getScorePb(a[i]), getScorePb(a[j]))
+}
+
+func lessSeriesPb(oi, oj *pb.SourceSeries, scorei, scorej int) bool {
 
 	// Higher score value means lower rank.
 	if scorei != scorej {
@@ -181,14 +151,14 @@ func (a SeriesByRank) Less(i, j int) bool {
 	}
 
 	latesti := ""
-	for date := range a[i].Val {
+	for date := range oi.Val {
 		if date > latesti {
 			latesti = date
 		}
 	}
 
 	latestj := ""
-	for date := range a[j].Val {
+	for date := range oj.Val {
 		if date > latestj {
 			latestj = date
 		}
@@ -200,8 +170,8 @@ func (a SeriesByRank) Less(i, j int) bool {
 	}
 
 	// Series with more data is ranked higher
-	if len(a[i].Val) != len(a[j].Val) {
-		return len(a[i].Val) > len(a[j].Val)
+	if len(oi.Val) != len(oj.Val) {
+		return len(oi.Val) > len(oj.Val)
 	}
 
 	// Compare other fields to get consistent ranking.
@@ -224,40 +194,10 @@ func (a SeriesByRank) Less(i, j int) bool {
 }
 
 // TODO(shifucun): Remove `SourceSeries` and use pb.SourceSeries everywhere.
-// getScore derives the ranking score for a source series.
-//
-// The score depends on ImportName and other SVObs properties, by checking the
-// StatsRanking dict. To get the score, ImportName is required, with optional
-// properties:
-// - MeasurementMethod
-// - ObservationPeriod
-//
-// When there are exact match of the properties in StatsRanking, then use that
-// score, otherwise can also match to wildcard options (indicated by *).
-//
-// If no entry is found, a BaseRank is assigned to the source series.
+// getScore derives the ranking score for a source series with the ranking
+// rules of all stat vars and place types.
 func getScore(s *model.SourceSeries) int {
-	for _, propCombination := range []struct {
-		mm string
-		op string
-	}{
-		// Check exact match first
-		{s.MeasurementMethod, s.ObservationPeriod},
-		{s.MeasurementMethod, "*"},
-		{"*", s.ObservationPeriod},
-		{"*", "*"},
-	} {
-		key := RankKey{
-			ImportName:        s.ImportName,
-			MeasurementMethod: propCombination.mm,
-			ObservationPeriod: propCombination.op,
-		}
-		score, ok := StatsRanking[key]
-		if ok {
-			return score
-		}
-	}
-	return BaseRank
+	return Options{}.score(s.ImportName, s.MeasurementMethod, s.ObservationPeriod)
 }
 
 // ByRank implements sort.Interface for []*SourceSeries based on
@@ -269,24 +209,24 @@ func (a ByRank) Len() int { return len(a) }
 func (a ByRank) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 
 func (a ByRank) Less(i, j int) bool {
-	oi := a[i]
-	scorei := getScore(oi)
-	oj := a[j]
-	scorej := getScore(oj)
+	return lessSeries(a[i], a[j], getScore(a[i]), getScore(a[j]))
+}
+
+func lessSeries(oi, oj *model.SourceSeries, scorei, scorej int) bool {
 	// Higher score value means lower rank.
 	if scorei != scorej {
 		return scorei < scorej
 	}
 
 	latesti := ""
-	for date := range a[i].Val {
+	for date := range oi.Val {
 		if date > latesti {
 			latesti = date
 		}
 	}
 
 	latestj := ""
-	for date := range a[j].Val {
+	for date := range oj.Val {
 		if date > latestj {
 			latestj = date
 		}
@@ -298,8 +238,8 @@ func (a ByRank) Less(i, j int) bool {
 	}
 
 	// Series with more data is ranked higher
-	if len(a[i].Val) != len(a[j].Val) {
-		return len(a[i].Val) > len(a[j].Val)
+	if len(oi.Val) != len(oj.Val) {
+		return len(oi.Val) > len(oj.Val)
 	}
 
 	// Compare other fields to get consistent ranking.
@@ -17,35 +17,185 @@ package search
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"cloud.google.com/go/bigquery"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/placeindex"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"google.golang.org/api/iterator"
 )
 
+// Number of entities returned from the place index when max_results is not
+// set, as a short prefix matches most places.
+const defaultMaxResults = 100
+
+var typeRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// searchIndex searches the places of the types in the place index, all the
+// places when types is empty, with the sections in the order of their best
+// ranked place.
+func searchIndex(
+	in *pb.SearchRequest, index *placeindex.Index, types []string,
+) *pb.SearchResponse {
+	maxResults := int(in.GetMaxResults())
+	if maxResults <= 0 {
+		maxResults = defaultMaxResults
+	}
+	places := index.Search(in.GetQuery(), types)
+	if len(places) > maxResults {
+		places = places[:maxResults]
+	}
+	out := &pb.SearchResponse{}
+	sections := map[string]*pb.SearchResultSection{}
+	for _, p := range places {
+		typeName := ""
+		for _, t := range p.Types {
+			if len(types) == 0 || containsString(types, t) {
+				typeName = t
+				break
+			}
+		}
+		section, ok := sections[typeName]
+		if !ok {
+			section = &pb.SearchResultSection{TypeName: typeName}
+			sections[typeName] = section
+			out.Section = append(out.Section, section)
+		}
+		section.Entity = append(
+			section.Entity,
+			&pb.SearchEntityResult{Dcid: p.Dcid, Name: p.Name},
+		)
+	}
+	return out
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// splitTypes splits the requested types into the types of the places in the
+// place index and the other types.
+func splitTypes(types, indexTypes []string) ([]string, []string) {
+	var inIndex, other []string
+	for _, t := range types {
+		if containsString(indexTypes, t) {
+			inIndex = append(inIndex, t)
+		} else {
+			other = append(other, t)
+		}
+	}
+	return inIndex, other
+}
+
+// numEntities returns the number of entities of a search response.
+func numEntities(out *pb.SearchResponse) int {
+	n := 0
+	for _, section := range out.Section {
+		n += len(section.Entity)
+	}
+	return n
+}
+
 // Search implements API for Mixer.Search.
+//
+// When the place index is loaded, the places of its types are searched in the
+// index, and other entities are searched in BigQuery. Otherwise all entities
+// are searched in BigQuery.
 func Search(
 	ctx context.Context,
 	in *pb.SearchRequest,
 	bqClient *bigquery.Client,
 	tableName string,
 ) (*pb.SearchResponse, error) {
+	for _, t := range in.GetTypes() {
+		if !typeRegex.MatchString(t) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid type: %s", t)
+		}
+	}
+	index := placeindex.CurrentIndex()
+	if index.Len() == 0 {
+		sections, err := searchBigQuery(
+			ctx, bqClient, tableName, in.GetQuery(), in.GetTypes(), nil, in.GetMaxResults())
+		if err != nil {
+			return nil, err
+		}
+		return &pb.SearchResponse{Section: sections}, nil
+	}
+
+	types := in.GetTypes()
+	indexTypes := index.Types()
+	placeTypes, otherTypes := splitTypes(types, indexTypes)
+	out := &pb.SearchResponse{}
+	if len(types) == 0 || len(placeTypes) > 0 {
+		out = searchIndex(in, index, placeTypes)
+	}
+	if bqClient == nil || (len(types) > 0 && len(otherTypes) == 0) {
+		return out, nil
+	}
+	maxResults := in.GetMaxResults()
+	if maxResults > 0 {
+		maxResults -= int32(numEntities(out))
+		if maxResults <= 0 {
+			return out, nil
+		}
+	}
+	// Without requested types, all the types other than those of the index.
+	var excludedTypes []string
+	if len(types) == 0 {
+		for _, t := range indexTypes {
+			if typeRegex.MatchString(t) {
+				excludedTypes = append(excludedTypes, t)
+			}
+		}
+	}
+	sections, err := searchBigQuery(
+		ctx, bqClient, tableName, in.GetQuery(), otherTypes, excludedTypes, maxResults)
+	if err != nil {
+		return nil, err
+	}
+	out.Section = append(out.Section, sections...)
+	return out, nil
+}
+
+// searchBigQuery searches the entities of the types in BigQuery, all the
+// types but the excluded types when types is empty.
+func searchBigQuery(
+	ctx context.Context,
+	bqClient *bigquery.Client,
+	tableName string,
+	query string,
+	types []string,
+	excludedTypes []string,
+	maxResults int32,
+) ([]*pb.SearchResultSection, error) {
 	result := map[string]*pb.SearchResultSection{}
-	tokens := strings.Split(strings.ToLower(in.GetQuery()), " ")
+	tokens := strings.Split(strings.ToLower(query), " ")
 	qStr := fmt.Sprintf(
 		"SELECT id, type, extended_name FROM `%s`.Instance "+
 			"WHERE type != \"CensusTract\" and type != \"PowerPlant\""+
 			" and type != \"PowerPlantUnit\""+
 			" and type != \"BiologicalSpecimen\"", tableName)
+	if len(types) > 0 {
+		qStr += fmt.Sprintf(` AND type IN ("%s")`, strings.Join(types, `", "`))
+	} else if len(excludedTypes) > 0 {
+		qStr += fmt.Sprintf(` AND type NOT IN ("%s")`, strings.Join(excludedTypes, `", "`))
+	}
 	for _, token := range tokens {
 		qStr += fmt.Sprintf(
 			` AND REGEXP_CONTAINS(LOWER(extended_name), r"\b%s\b")`, token)
 	}
-	if in.GetMaxResults() > 0 {
-		qStr += fmt.Sprintf(" LIMIT %d", in.GetMaxResults())
+	if maxResults > 0 {
+		qStr += fmt.Sprintf(" LIMIT %d", maxResults)
 	}
 	q := bqClient.Query(qStr)
 	it, err := q.Read(ctx)
@@ -75,9 +225,9 @@ func Search(
 			&pb.SearchEntityResult{Dcid: dcid, Name: name},
 		)
 	}
-	out := pb.SearchResponse{}
+	sections := []*pb.SearchResultSection{}
 	for _, v := range result {
-		out.Section = append(out.Section, v)
+		sections = append(sections, v)
 	}
-	return &out, nil
+	return sections, nil
 }
@@ -0,0 +1,86 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/placeindex"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestSplitTypes(t *testing.T) {
+	placeTypes, otherTypes := splitTypes(
+		[]string{"City", "Person", "County"}, []string{"City", "County"})
+	if diff := cmp.Diff(placeTypes, []string{"City", "County"}); diff != "" {
+		t.Errorf("splitTypes() got diff place types %v", diff)
+	}
+	if diff := cmp.Diff(otherTypes, []string{"Person"}); diff != "" {
+		t.Errorf("splitTypes() got diff other types %v", diff)
+	}
+}
+
+func TestSearchPlaceIndex(t *testing.T) {
+	defer placeindex.SetIndex(placeindex.CurrentIndex())
+	placeindex.SetIndex(placeindex.NewIndex([]*placeindex.Place{
+		{Dcid: "geoId/0668000", Name: "San Jose", Types: []string{"City"}, Population: 1021795},
+		{Dcid: "geoId/06085", Name: "Santa Clara County", Types: []string{"County"}, Population: 1927852},
+	}))
+
+	// Without BigQuery, only the places of the index are returned.
+	for _, c := range []struct {
+		types []string
+		want  *pb.SearchResponse
+	}{
+		{
+			nil,
+			&pb.SearchResponse{Section: []*pb.SearchResultSection{
+				{
+					TypeName: "County",
+					Entity:   []*pb.SearchEntityResult{{Dcid: "geoId/06085", Name: "Santa Clara County"}},
+				},
+				{
+					TypeName: "City",
+					Entity:   []*pb.SearchEntityResult{{Dcid: "geoId/0668000", Name: "San Jose"}},
+				},
+			}},
+		},
+		{
+			[]string{"City", "Person"},
+			&pb.SearchResponse{Section: []*pb.SearchResultSection{
+				{
+					TypeName: "City",
+					Entity:   []*pb.SearchEntityResult{{Dcid: "geoId/0668000", Name: "San Jose"}},
+				},
+			}},
+		},
+		{
+			[]string{"Person"},
+			&pb.SearchResponse{},
+		},
+	} {
+		got, err := Search(
+			context.Background(), &pb.SearchRequest{Query: "san", Types: c.types}, nil, "")
+		if err != nil {
+			t.Fatalf("Search(%v) got error %v", c.types, err)
+		}
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Search(%v) got diff %v", c.types, diff)
+		}
+	}
+}
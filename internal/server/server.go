@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"path"
@@ -29,6 +30,8 @@ import (
 	"cloud.google.com/go/storage"
 	"github.com/datacommonsorg/mixer/internal/parser/mcf"
 	dcpubsub "github.com/datacommonsorg/mixer/internal/pubsub"
+	"github.com/datacommonsorg/mixer/internal/server/place"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
 	"github.com/datacommonsorg/mixer/internal/server/resource"
 	"github.com/datacommonsorg/mixer/internal/server/statvar"
 	"github.com/datacommonsorg/mixer/internal/store"
@@ -143,6 +146,60 @@ func (s *Server) SubscribeBranchCacheUpdate(
 	)
 }
 
+// LoadRankingConfig loads the source ranking config from a GCS object like
+// "gs://bucket/ranking.json" or a local file.
+func LoadRankingConfig(ctx context.Context, configPath string) error {
+	var data []byte
+	if strings.HasPrefix(configPath, "gs://") {
+		parts := strings.SplitN(strings.TrimPrefix(configPath, "gs://"), "/", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid GCS path: %s", configPath)
+		}
+		client, err := storage.NewClient(ctx)
+		if err != nil {
+			return err
+		}
+		rc, err := client.Bucket(parts[0]).Object(parts[1]).NewReader(ctx)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		data, err = ioutil.ReadAll(rc)
+		if err != nil {
+			return err
+		}
+	} else {
+		var err error
+		data, err = ioutil.ReadFile(configPath)
+		if err != nil {
+			return err
+		}
+	}
+	return ranking.LoadConfig(data)
+}
+
+// SubscribeRankingConfigUpdate subscribes for ranking config update, and
+// reloads the config on each message.
+func SubscribeRankingConfigUpdate(
+	ctx context.Context, pubsubProject, subscriberPrefix, pubsubTopic, configPath string,
+) error {
+	return dcpubsub.Subscribe(
+		ctx,
+		pubsubProject,
+		subscriberPrefix,
+		pubsubTopic,
+		func(ctx context.Context, msg *pubsub.Message) error {
+			log.Printf("Ranking Config Subscriber: reload %s\n", configPath)
+			return LoadRankingConfig(ctx, configPath)
+		},
+	)
+}
+
+// AggregateMemDb aggregates private import data to ancestor places.
+func (s *Server) AggregateMemDb(ctx context.Context) error {
+	return place.AggregateMemDb(ctx, s.store)
+}
+
 // NewCache initializes the cache for stat var hierarchy.
 func NewCache(ctx context.Context, baseTable *bigtable.Table) (*resource.Cache, error) {
 	rawSvg, err := statvar.GetRawSvg(ctx, baseTable)
@@ -0,0 +1,125 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"strings"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// granularityLength maps date granularity to the length of ISO 8601 date.
+var granularityLength = map[string]int{
+	"year":  len("2020"),
+	"month": len("2020-01"),
+	"day":   len("2020-01-01"),
+}
+
+// dateFilter selects observations by date range and granularity.
+type dateFilter struct {
+	startDate string
+	endDate   string
+	// Length of the dates to keep, 0 means all.
+	dateLength int
+}
+
+// newDateFilter creates a dateFilter from request arguments. Returns nil if no
+// filter is requested.
+func newDateFilter(startDate, endDate, granularity string) (*dateFilter, error) {
+	if startDate == "" && endDate == "" && granularity == "" {
+		return nil, nil
+	}
+	if startDate != "" && endDate != "" && startDate > endDate &&
+		!strings.HasPrefix(startDate, endDate) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"start_date %s is after end_date %s", startDate, endDate)
+	}
+	f := &dateFilter{startDate: startDate, endDate: endDate}
+	if granularity != "" {
+		length, ok := granularityLength[strings.ToLower(granularity)]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Invalid granularity %s, should be one of year, month and day", granularity)
+		}
+		f.dateLength = length
+	}
+	return f, nil
+}
+
+// keep checks if an observation date passes the filter.
+func (f *dateFilter) keep(date string) bool {
+	if f.dateLength > 0 && len(date) != f.dateLength {
+		return false
+	}
+	if f.startDate != "" && date < f.startDate {
+		return false
+	}
+	if f.endDate != "" && date > f.endDate && !strings.HasPrefix(date, f.endDate+"-") {
+		return false
+	}
+	return true
+}
+
+// filterVal returns the observations that pass the filter. The input is not
+// modified. A nil filter returns the input.
+func (f *dateFilter) filterVal(val map[string]float64) map[string]float64 {
+	if f == nil {
+		return val
+	}
+	result := map[string]float64{}
+	for date, v := range val {
+		if f.keep(date) {
+			result[date] = v
+		}
+	}
+	return result
+}
+
+// filterSeries applies the filter to a series. Returns nil if no observation
+// is left.
+func (f *dateFilter) filterSeries(series *pb.Series) *pb.Series {
+	if f == nil || series == nil {
+		return series
+	}
+	val := f.filterVal(series.Val)
+	if len(val) == 0 {
+		return nil
+	}
+	return &pb.Series{
+		Val:                 val,
+		Metadata:            series.Metadata,
+		DenominatorMetadata: series.DenominatorMetadata,
+		FilledDates:         keepFilledDates(series.FilledDates, val),
+		MetaHashes:          keepMetaHashes(series.MetaHashes, val),
+	}
+}
+
+// filterSourceSeries applies the filter to source series, dropping the ones
+// without observation left.
+func (f *dateFilter) filterSourceSeries(in []*pb.SourceSeries) []*pb.SourceSeries {
+	if f == nil {
+		return in
+	}
+	result := []*pb.SourceSeries{}
+	for _, series := range in {
+		series.Val = f.filterVal(series.Val)
+		if len(series.Val) > 0 {
+			result = append(result, series)
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDateFilter(t *testing.T) {
+	val := map[string]float64{
+		"2019":       1,
+		"2019-12":    2,
+		"2020":       3,
+		"2020-01":    4,
+		"2020-01-15": 5,
+		"2020-12-31": 6,
+		"2021-01-01": 7,
+	}
+	for _, c := range []struct {
+		startDate   string
+		endDate     string
+		granularity string
+		want        map[string]float64
+	}{
+		{"", "", "", val},
+		{"2020", "", "", map[string]float64{
+			"2020": 3, "2020-01": 4, "2020-01-15": 5, "2020-12-31": 6, "2021-01-01": 7,
+		}},
+		{"2019-12", "2020", "", map[string]float64{
+			"2019-12": 2, "2020": 3, "2020-01": 4, "2020-01-15": 5, "2020-12-31": 6,
+		}},
+		{"", "2020-01", "day", map[string]float64{"2020-01-15": 5}},
+		{"", "", "Year", map[string]float64{"2019": 1, "2020": 3}},
+		{"", "", "month", map[string]float64{"2019-12": 2, "2020-01": 4}},
+	} {
+		f, err := newDateFilter(c.startDate, c.endDate, c.granularity)
+		if err != nil {
+			t.Fatalf("newDateFilter() got error %v", err)
+		}
+		if diff := cmp.Diff(f.filterVal(val), c.want); diff != "" {
+			t.Errorf("filterVal(%s, %s, %s) got diff: %v",
+				c.startDate, c.endDate, c.granularity, diff)
+		}
+	}
+
+	for _, c := range []struct {
+		startDate   string
+		endDate     string
+		granularity string
+	}{
+		{"2021", "2020", ""},
+		{"", "", "week"},
+	} {
+		if _, err := newDateFilter(c.startDate, c.endDate, c.granularity); err == nil {
+			t.Errorf("newDateFilter(%s, %s, %s) should return error",
+				c.startDate, c.endDate, c.granularity)
+		}
+	}
+}
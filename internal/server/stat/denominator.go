@@ -0,0 +1,174 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/store"
+)
+
+// dateLayouts are the supported ISO 8601 date layouts, used to measure the
+// distance between dates.
+var dateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+
+func parseDate(date string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// closestDate finds the date in the series closest to the given date. An exact
+// match is always preferred; on a tie, the earlier date is picked.
+func closestDate(val map[string]float64, date string) (string, bool) {
+	if _, ok := val[date]; ok {
+		return date, true
+	}
+	target, ok := parseDate(date)
+	if !ok {
+		return "", false
+	}
+	result := ""
+	var minDistance time.Duration
+	for d := range val {
+		t, ok := parseDate(d)
+		if !ok {
+			continue
+		}
+		distance := t.Sub(target)
+		if distance < 0 {
+			distance = -distance
+		}
+		if result == "" || distance < minDistance ||
+			(distance == minDistance && d < result) {
+			result = d
+			minDistance = distance
+		}
+	}
+	return result, result != ""
+}
+
+// readDenominators reads the denominator series of places, picking the source
+// the same way as GetStatSetSeries with the preferred and excluded imports of
+// the ranking options. The denominator keeps its own unit, the target unit of
+// the request only applies to the numerator.
+func readDenominators(
+	ctx context.Context,
+	store *store.Store,
+	places []string,
+	denominator string,
+	rankOpts ranking.Options,
+) (map[string]*pb.Series, error) {
+	resp, err := GetStatSetSeries(
+		ctx,
+		&pb.GetStatSetSeriesRequest{
+			Places:           places,
+			StatVars:         []string{denominator},
+			PreferredImports: rankOpts.PreferredImports,
+			ExcludedImports:  rankOpts.ExcludedImports,
+		},
+		store,
+	)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]*pb.Series{}
+	for place, seriesMap := range resp.Data {
+		series := seriesMap.Data[denominator]
+		if series == nil {
+			continue
+		}
+		result[place] = series
+	}
+	return result, nil
+}
+
+// divideSeries divides each value of a series by the denominator value with
+// the closest date. Dates without a non-zero denominator are dropped.
+func divideSeries(series, denom *pb.Series) *pb.Series {
+	result := &pb.Series{
+		Val:                 map[string]float64{},
+		Metadata:            series.Metadata,
+		DenominatorMetadata: denom.Metadata,
+		MetaHash:            series.MetaHash,
+	}
+	for date, value := range series.Val {
+		denomDate, ok := closestDate(denom.Val, date)
+		if !ok || denom.Val[denomDate] == 0 {
+			continue
+		}
+		result.Val[date] = value / denom.Val[denomDate]
+	}
+	result.FilledDates = keepFilledDates(series.FilledDates, result.Val)
+	result.MetaHashes = keepMetaHashes(series.MetaHashes, result.Val)
+	return result
+}
+
+// applyDenominatorToStatSet converts the point stats of a GetStatSetResponse to
+// ratios to the denominator. Stats without denominator data are dropped.
+func applyDenominatorToStatSet(
+	ctx context.Context,
+	store *store.Store,
+	result *pb.GetStatSetResponse,
+	denominator string,
+	rankOpts ranking.Options,
+) error {
+	placeSet := map[string]struct{}{}
+	for _, placeStat := range result.Data {
+		for place := range placeStat.Stat {
+			placeSet[place] = struct{}{}
+		}
+	}
+	places := make([]string, 0, len(placeSet))
+	for place := range placeSet {
+		places = append(places, place)
+	}
+	if len(places) == 0 {
+		return nil
+	}
+	denoms, err := readDenominators(ctx, store, places, denominator, rankOpts)
+	if err != nil {
+		return err
+	}
+	for _, placeStat := range result.Data {
+		for place, stat := range placeStat.Stat {
+			if stat == nil {
+				continue
+			}
+			denom, ok := denoms[place]
+			if !ok {
+				placeStat.Stat[place] = nil
+				continue
+			}
+			denomDate, ok := closestDate(denom.Val, stat.Date)
+			if !ok || denom.Val[denomDate] == 0 {
+				placeStat.Stat[place] = nil
+				continue
+			}
+			metaHash := getMetadataHash(denom.Metadata)
+			stat.Value /= denom.Val[denomDate]
+			stat.DenominatorMetaHash = metaHash
+			stat.DenominatorDate = denomDate
+			result.Metadata[metaHash] = denom.Metadata
+		}
+	}
+	return nil
+}
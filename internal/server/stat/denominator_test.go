@@ -0,0 +1,122 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestClosestDate(t *testing.T) {
+	val := map[string]float64{
+		"2018":    1,
+		"2020":    2,
+		"2020-06": 3,
+	}
+	for _, c := range []struct {
+		date   string
+		want   string
+		wantOk bool
+	}{
+		{"2020", "2020", true},
+		{"2019", "2018", true},
+		{"2020-05-20", "2020-06", true},
+		{"2017-12-31", "2018", true},
+		{"2025", "2020-06", true},
+		{"bad", "", false},
+	} {
+		got, ok := closestDate(val, c.date)
+		if got != c.want || ok != c.wantOk {
+			t.Errorf("closestDate(%s) = %s, %v, want %s, %v",
+				c.date, got, ok, c.want, c.wantOk)
+		}
+	}
+}
+
+func TestDivideSeries(t *testing.T) {
+	meta := &pb.StatMetadata{ImportName: "BLS"}
+	denomMeta := &pb.StatMetadata{ImportName: "CensusPEP"}
+	got := divideSeries(
+		&pb.Series{
+			Val:      map[string]float64{"2019": 100, "2020": 300, "2021-03": 50},
+			Metadata: meta,
+		},
+		&pb.Series{
+			Val:      map[string]float64{"2019": 0, "2020": 1000},
+			Metadata: denomMeta,
+		},
+	)
+	want := &pb.Series{
+		Val:                 map[string]float64{"2020": 0.3, "2021-03": 0.05},
+		Metadata:            meta,
+		DenominatorMetadata: denomMeta,
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("divideSeries() got diff: %v", diff)
+	}
+}
+
+func TestDenominatorBeforeTransform(t *testing.T) {
+	meta := &pb.StatMetadata{ImportName: "BLS"}
+	denomMeta := &pb.StatMetadata{ImportName: "CensusPEP"}
+	pipeline := &seriesPipeline{
+		denoms: map[string]*pb.Series{
+			"geoId/06": {
+				Val:      map[string]float64{"2019": 10, "2020": 20},
+				Metadata: denomMeta,
+			},
+		},
+		fill:      fillNone,
+		transform: transformYoyPct,
+	}
+	series := &pb.Series{
+		Val:      map[string]float64{"2019": 100, "2020": 300},
+		Metadata: meta,
+	}
+	// The per capita value grows from 10 to 15.
+	got := pipeline.process("geoId/06", "Count_Worker", series)
+	want := &pb.Series{
+		Val:                 map[string]float64{"2020": 50},
+		Metadata:            meta,
+		DenominatorMetadata: denomMeta,
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform(),
+		cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+		t.Errorf("process() got diff: %v", diff)
+	}
+	if got := pipeline.process("geoId/48", "Count_Worker", series); got != nil {
+		t.Errorf("process() without denominator = %v, want nil", got)
+	}
+
+	// The point transform can not be divided by a single denominator value.
+	_, err := GetStatSet(context.Background(), &pb.GetStatSetRequest{
+		Places:      []string{"geoId/06"},
+		StatVars:    []string{"Count_Worker"},
+		Date:        "2020",
+		BaseDate:    "2019",
+		Transform:   transformYoyPct,
+		Denominator: "Count_Person",
+	}, nil)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetStatSet() got error %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Gap filling modes.
+const (
+	fillNone         = "none"
+	fillCarryForward = "carry_forward"
+	fillLinear       = "linear"
+)
+
+// dateSteps maps the length of ISO 8601 date to its layout and the step to
+// the next date.
+var dateSteps = map[int]struct {
+	layout              string
+	years, months, days int
+}{
+	len("2020"):       {"2006", 1, 0, 0},
+	len("2020-01"):    {"2006-01", 0, 1, 0},
+	len("2020-01-01"): {"2006-01-02", 0, 0, 1},
+}
+
+// parseFill validates the gap filling mode of a request. Empty means none.
+func parseFill(fill string) (string, error) {
+	fill = strings.ToLower(fill)
+	switch fill {
+	case "", fillNone:
+		return fillNone, nil
+	case fillCarryForward, fillLinear:
+		return fill, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument,
+		"Invalid fill %s, should be one of none, carry_forward and linear", fill)
+}
+
+// fillGaps fills the missing dates between the first and last observation.
+// The granularity is the most common date length in the series, dates of other
+// granularity are kept as is. Returns the filled series and the sorted filled
+// dates.
+func fillGaps(val map[string]float64, mode string) (map[string]float64, []string) {
+	if mode == fillNone || len(val) < 2 {
+		return val, nil
+	}
+	lengthCount := map[int]int{}
+	for date := range val {
+		lengthCount[len(date)]++
+	}
+	length := 0
+	for l, count := range lengthCount {
+		if count > lengthCount[length] || (count == lengthCount[length] && l > length) {
+			length = l
+		}
+	}
+	step, ok := dateSteps[length]
+	if !ok {
+		return val, nil
+	}
+	dates := []string{}
+	for date := range val {
+		if len(date) == length {
+			dates = append(dates, date)
+		}
+	}
+	sort.Strings(dates)
+
+	result := make(map[string]float64, len(val))
+	for date, v := range val {
+		result[date] = v
+	}
+	filled := []string{}
+	for i := 0; i+1 < len(dates); i++ {
+		start, err := time.Parse(step.layout, dates[i])
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(step.layout, dates[i+1])
+		if err != nil {
+			continue
+		}
+		// Dates strictly between two observations.
+		gap := []string{}
+		for t := start.AddDate(step.years, step.months, step.days); t.Before(end); t = t.AddDate(step.years, step.months, step.days) {
+			gap = append(gap, t.Format(step.layout))
+		}
+		v0, v1 := val[dates[i]], val[dates[i+1]]
+		for k, date := range gap {
+			if mode == fillLinear {
+				result[date] = v0 + (v1-v0)*float64(k+1)/float64(len(gap)+1)
+			} else {
+				result[date] = v0
+			}
+			filled = append(filled, date)
+		}
+	}
+	return result, filled
+}
+
+// fillSeries fills the gaps of a series. The input is not modified.
+func fillSeries(series *pb.Series, mode string) *pb.Series {
+	if series == nil || mode == fillNone {
+		return series
+	}
+	val, filled := fillGaps(series.Val, mode)
+	return &pb.Series{
+		Val:                 val,
+		Metadata:            series.Metadata,
+		DenominatorMetadata: series.DenominatorMetadata,
+		FilledDates:         filled,
+		MetaHashes:          series.MetaHashes,
+	}
+}
+
+// keepFilledDates returns the filled dates that are still in the series.
+func keepFilledDates(filled []string, val map[string]float64) []string {
+	if len(filled) == 0 {
+		return nil
+	}
+	result := []string{}
+	for _, date := range filled {
+		if _, ok := val[date]; ok {
+			result = append(result, date)
+		}
+	}
+	return result
+}
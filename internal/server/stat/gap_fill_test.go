@@ -0,0 +1,69 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFillGaps(t *testing.T) {
+	for _, c := range []struct {
+		val        map[string]float64
+		mode       string
+		want       map[string]float64
+		wantFilled []string
+	}{
+		{
+			map[string]float64{"2015": 10, "2018": 40, "2019": 50},
+			fillNone,
+			map[string]float64{"2015": 10, "2018": 40, "2019": 50},
+			nil,
+		},
+		{
+			map[string]float64{"2015": 10, "2018": 40, "2019": 50},
+			fillCarryForward,
+			map[string]float64{"2015": 10, "2016": 10, "2017": 10, "2018": 40, "2019": 50},
+			[]string{"2016", "2017"},
+		},
+		{
+			map[string]float64{"2015": 10, "2018": 40, "2019": 50},
+			fillLinear,
+			map[string]float64{"2015": 10, "2016": 20, "2017": 30, "2018": 40, "2019": 50},
+			[]string{"2016", "2017"},
+		},
+		{
+			// Monthly series, the yearly value is kept as is.
+			map[string]float64{"2019": 5, "2019-11": 1, "2020-02": 4},
+			fillLinear,
+			map[string]float64{
+				"2019": 5, "2019-11": 1, "2019-12": 2, "2020-01": 3, "2020-02": 4,
+			},
+			[]string{"2019-12", "2020-01"},
+		},
+	} {
+		got, filled := fillGaps(c.val, c.mode)
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("fillGaps(%v, %s) got diff: %v", c.val, c.mode, diff)
+		}
+		if diff := cmp.Diff(filled, c.wantFilled); diff != "" {
+			t.Errorf("fillGaps(%v, %s) got diff filled dates: %v", c.val, c.mode, diff)
+		}
+	}
+	if _, err := parseFill("spline"); err == nil {
+		t.Errorf("parseFill(spline) should return error")
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// metadataFilter keeps the series matching the requested metadata. Empty
+// fields match any value.
+type metadataFilter struct {
+	measurementMethod string
+	observationPeriod string
+	unit              string
+	scalingFactor     string
+	// nil matches both aggregated and imported series.
+	isDcAggregate *bool
+	// Import names that never match.
+	excludedImports []string
+}
+
+// newMetadataFilter builds the metadata filter of a GetStatSetSeries request.
+func newMetadataFilter(in *pb.GetStatSetSeriesRequest) (*metadataFilter, error) {
+	f := &metadataFilter{
+		measurementMethod: in.GetMeasurementMethod(),
+		observationPeriod: in.GetObservationPeriod(),
+		unit:              in.GetUnit(),
+		scalingFactor:     in.GetScalingFactor(),
+		excludedImports:   in.GetExcludedImports(),
+	}
+	switch in.GetIsDcAggregate() {
+	case "":
+	case "true":
+		v := true
+		f.isDcAggregate = &v
+	case "false":
+		v := false
+		f.isDcAggregate = &v
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Invalid is_dc_aggregate: %s", in.GetIsDcAggregate())
+	}
+	return f, nil
+}
+
+func (f *metadataFilter) match(m *pb.StatMetadata) bool {
+	for _, excluded := range f.excludedImports {
+		if excluded == m.GetImportName() {
+			return false
+		}
+	}
+	return (f.measurementMethod == "" || f.measurementMethod == m.GetMeasurementMethod()) &&
+		(f.observationPeriod == "" || f.observationPeriod == m.GetObservationPeriod()) &&
+		(f.unit == "" || f.unit == m.GetUnit()) &&
+		(f.scalingFactor == "" || f.scalingFactor == m.GetScalingFactor()) &&
+		(f.isDcAggregate == nil || *f.isDcAggregate == m.GetIsDcAggregate())
+}
+
+// filterSourceSeries returns the source series matching the filter.
+func (f *metadataFilter) filterSourceSeries(in []*pb.SourceSeries) []*pb.SourceSeries {
+	result := []*pb.SourceSeries{}
+	for _, series := range in {
+		if f.match(sourceSeriesMetadata(series)) {
+			result = append(result, series)
+		}
+	}
+	return result
+}
+
+// sourceSeriesMetadata returns the full metadata of a source series, including
+// whether it is aggregated by Data Commons.
+func sourceSeriesMetadata(in *pb.SourceSeries) *pb.StatMetadata {
+	return &pb.StatMetadata{
+		ImportName:        in.ImportName,
+		ProvenanceUrl:     in.ProvenanceUrl,
+		MeasurementMethod: in.MeasurementMethod,
+		ObservationPeriod: in.ObservationPeriod,
+		ScalingFactor:     in.ScalingFactor,
+		Unit:              in.Unit,
+		IsDcAggregate:     in.IsDcAggregate,
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMetadataFilter(t *testing.T) {
+	census := &pb.SourceSeries{
+		ImportName:        "CensusACS5YearSurvey",
+		MeasurementMethod: "CensusACS5yrSurvey",
+		ObservationPeriod: "P1Y",
+	}
+	pep := &pb.SourceSeries{
+		ImportName:        "CensusPEP",
+		MeasurementMethod: "CensusPEPSurvey",
+		ObservationPeriod: "P1Y",
+	}
+	aggregate := &pb.SourceSeries{
+		ImportName:        "CensusPEP",
+		MeasurementMethod: "CensusPEPSurvey",
+		ObservationPeriod: "P1Y",
+		IsDcAggregate:     true,
+	}
+	all := []*pb.SourceSeries{census, pep, aggregate}
+
+	for _, c := range []struct {
+		in   *pb.GetStatSetSeriesRequest
+		want []*pb.SourceSeries
+	}{
+		{&pb.GetStatSetSeriesRequest{}, all},
+		{&pb.GetStatSetSeriesRequest{ObservationPeriod: "P1Y"}, all},
+		{&pb.GetStatSetSeriesRequest{ObservationPeriod: "P1M"}, []*pb.SourceSeries{}},
+		{
+			&pb.GetStatSetSeriesRequest{MeasurementMethod: "CensusPEPSurvey"},
+			[]*pb.SourceSeries{pep, aggregate},
+		},
+		{
+			&pb.GetStatSetSeriesRequest{
+				MeasurementMethod: "CensusPEPSurvey",
+				IsDcAggregate:     "false",
+			},
+			[]*pb.SourceSeries{pep},
+		},
+		{
+			&pb.GetStatSetSeriesRequest{IsDcAggregate: "true"},
+			[]*pb.SourceSeries{aggregate},
+		},
+	} {
+		f, err := newMetadataFilter(c.in)
+		if err != nil {
+			t.Fatalf("newMetadataFilter(%v) got error %v", c.in, err)
+		}
+		got := f.filterSourceSeries(all)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("filterSourceSeries(%v) got diff: %v", c.in, diff)
+		}
+	}
+
+	if _, err := newMetadataFilter(
+		&pb.GetStatSetSeriesRequest{IsDcAggregate: "yes"}); err == nil {
+		t.Errorf("newMetadataFilter() should fail on invalid is_dc_aggregate")
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"context"
+
+	"github.com/datacommonsorg/mixer/internal/server/node"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/store"
+)
+
+// getRankingPlaceTypes returns the place type used to rank the series of each
+// place. Place types are only read when there are place type ranking rules.
+func getRankingPlaceTypes(
+	ctx context.Context, store *store.Store, places []string) (map[string]string, error) {
+	result := map[string]string{}
+	if !ranking.HasPlaceTypeRules() {
+		return result, nil
+	}
+	typeNodes, err := node.GetPropertyValuesHelper(ctx, store, places, "typeOf", true)
+	if err != nil {
+		return nil, err
+	}
+	for place, nodes := range typeNodes {
+		types := []string{}
+		for _, n := range nodes {
+			types = append(types, n.Dcid)
+		}
+		result[place] = ranking.RankedPlaceType(types)
+	}
+	return result, nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/node"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Aggregation methods for resampling.
+const (
+	aggSum  = "sum"
+	aggMean = "mean"
+	aggLast = "last"
+	aggMin  = "min"
+	aggMax  = "max"
+)
+
+var aggregations = map[string]struct{}{
+	aggSum:  {},
+	aggMean: {},
+	aggLast: {},
+	aggMin:  {},
+	aggMax:  {},
+}
+
+// statTypeAggregation maps stat var statType to the aggregation method.
+// measuredValue can be a stock or a flow, so it uses the conservative "last".
+var statTypeAggregation = map[string]string{
+	"measuredValue": aggLast,
+	"sumValue":      aggSum,
+	"meanValue":     aggMean,
+	"minValue":      aggMin,
+	"maxValue":      aggMax,
+}
+
+// resamplePeriods maps the resample target to the observation period.
+var resamplePeriods = map[string]string{
+	"year":  "P1Y",
+	"month": "P1M",
+}
+
+// resampler converts series to a coarser date granularity.
+type resampler struct {
+	// Length of the target ISO 8601 date.
+	dateLength int
+	// Observation period of the resampled series.
+	period string
+	// Aggregation method keyed by stat var.
+	methods map[string]string
+}
+
+// newResampler creates a resampler from request arguments. Returns nil if no
+// resampling is requested. When aggregation is not given, the method of each
+// stat var is derived from its statType.
+func newResampler(
+	ctx context.Context,
+	store *store.Store,
+	resample, aggregation string,
+	statVars []string,
+) (*resampler, error) {
+	if resample == "" {
+		if aggregation != "" {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"aggregation requires resample")
+		}
+		return nil, nil
+	}
+	resample = strings.ToLower(resample)
+	period, ok := resamplePeriods[resample]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Invalid resample %s, should be one of year and month", resample)
+	}
+	r := &resampler{
+		dateLength: granularityLength[resample],
+		period:     period,
+		methods:    map[string]string{},
+	}
+	if aggregation != "" {
+		aggregation = strings.ToLower(aggregation)
+		if _, ok := aggregations[aggregation]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Invalid aggregation %s, should be one of sum, mean, last, min and max",
+				aggregation)
+		}
+		for _, statVar := range statVars {
+			r.methods[statVar] = aggregation
+		}
+		return r, nil
+	}
+	statTypes, err := node.GetPropertyValuesHelper(ctx, store, statVars, "statType", true)
+	if err != nil {
+		return nil, err
+	}
+	for _, statVar := range statVars {
+		r.methods[statVar] = aggLast
+		for _, n := range statTypes[statVar] {
+			if method, ok := statTypeAggregation[n.Dcid]; ok {
+				r.methods[statVar] = method
+				break
+			}
+		}
+	}
+	return r, nil
+}
+
+// method returns the aggregation method of a stat var.
+func (r *resampler) method(statVar string) string {
+	if method, ok := r.methods[statVar]; ok {
+		return method
+	}
+	return aggLast
+}
+
+// subPeriods returns the number of dates of a length in a period, or 0 if
+// either length is not a supported date granularity.
+func subPeriods(period string, dateLength int) int {
+	periodStep, ok := dateSteps[len(period)]
+	if !ok {
+		return 0
+	}
+	step, ok := dateSteps[dateLength]
+	if !ok {
+		return 0
+	}
+	start, err := time.Parse(periodStep.layout, period)
+	if err != nil {
+		return 0
+	}
+	end := start.AddDate(periodStep.years, periodStep.months, periodStep.days)
+	n := 0
+	for t := start; t.Before(end); t = t.AddDate(step.years, step.months, step.days) {
+		n++
+	}
+	return n
+}
+
+// complete returns whether the dates of a period cover the whole period, all
+// at the same granularity.
+func complete(period string, dates []string) bool {
+	for _, date := range dates {
+		if len(date) != len(dates[0]) {
+			return false
+		}
+	}
+	return len(dates) == subPeriods(period, len(dates[0]))
+}
+
+// resampleVal groups the observations by target period and aggregates each
+// group. Observations coarser than the target are dropped. With "sum", periods
+// missing some of their observations are dropped, as their total would be
+// partial.
+func (r *resampler) resampleVal(val map[string]float64, method string) map[string]float64 {
+	groups := map[string][]string{}
+	for date := range val {
+		if len(date) < r.dateLength {
+			continue
+		}
+		period := date[:r.dateLength]
+		groups[period] = append(groups[period], date)
+	}
+	result := map[string]float64{}
+	for period, dates := range groups {
+		if method == aggSum && !complete(period, dates) {
+			continue
+		}
+		sort.Strings(dates)
+		var agg float64
+		switch method {
+		case aggSum, aggMean:
+			for _, date := range dates {
+				agg += val[date]
+			}
+			if method == aggMean {
+				agg /= float64(len(dates))
+			}
+		case aggMin:
+			agg = math.Inf(1)
+			for _, date := range dates {
+				agg = math.Min(agg, val[date])
+			}
+		case aggMax:
+			agg = math.Inf(-1)
+			for _, date := range dates {
+				agg = math.Max(agg, val[date])
+			}
+		default:
+			agg = val[dates[len(dates)-1]]
+		}
+		result[period] = agg
+	}
+	return result
+}
+
+// resampleSeries resamples a series. The input is not modified. A nil
+// resampler returns the input. Date metadata hashes of a stitched series are
+// dropped as a period can aggregate dates from different sources.
+func (r *resampler) resampleSeries(statVar string, series *pb.Series) *pb.Series {
+	if r == nil || series == nil {
+		return series
+	}
+	meta := &pb.StatMetadata{}
+	if series.Metadata != nil {
+		meta = proto.Clone(series.Metadata).(*pb.StatMetadata)
+	}
+	meta.ObservationPeriod = r.period
+	return &pb.Series{
+		Val:      r.resampleVal(series.Val, r.method(statVar)),
+		Metadata: meta,
+	}
+}
+
+// resampleSourceSeries resamples source series in place.
+func (r *resampler) resampleSourceSeries(statVar string, in []*pb.SourceSeries) {
+	if r == nil {
+		return
+	}
+	for _, series := range in {
+		series.Val = r.resampleVal(series.Val, r.method(statVar))
+		series.ObservationPeriod = r.period
+	}
+}
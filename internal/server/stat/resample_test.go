@@ -0,0 +1,123 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestResampleVal(t *testing.T) {
+	val := map[string]float64{
+		"2020":       100,
+		"2020-01-01": 1,
+		"2020-01-15": 3,
+		"2020-01-31": 2,
+		"2020-02-01": 5,
+	}
+	r := &resampler{dateLength: 7, period: "P1M"}
+	for _, c := range []struct {
+		method string
+		want   map[string]float64
+	}{
+		// Months with only some days are dropped.
+		{aggSum, map[string]float64{}},
+		{aggMean, map[string]float64{"2020-01": 2, "2020-02": 5}},
+		{aggLast, map[string]float64{"2020-01": 2, "2020-02": 5}},
+		{aggMin, map[string]float64{"2020-01": 1, "2020-02": 5}},
+		{aggMax, map[string]float64{"2020-01": 3, "2020-02": 5}},
+	} {
+		if diff := cmp.Diff(r.resampleVal(val, c.method), c.want); diff != "" {
+			t.Errorf("resampleVal(%s) got diff: %v", c.method, diff)
+		}
+	}
+}
+
+func TestResampleValSumComplete(t *testing.T) {
+	val := map[string]float64{"2019-12": 1}
+	for month := 1; month <= 12; month++ {
+		val[fmt.Sprintf("2020-%02d", month)] = float64(month)
+	}
+	r := &resampler{dateLength: 4, period: "P1Y"}
+	want := map[string]float64{"2020": 78}
+	if diff := cmp.Diff(r.resampleVal(val, aggSum), want); diff != "" {
+		t.Errorf("resampleVal(sum) got diff: %v", diff)
+	}
+
+	val = map[string]float64{"2020-02": 10}
+	for day := 1; day <= 29; day++ {
+		val[fmt.Sprintf("2020-02-%02d", day)] = 1
+	}
+	r = &resampler{dateLength: 7, period: "P1M"}
+	// Days mixed with the month itself would be counted twice.
+	if diff := cmp.Diff(r.resampleVal(val, aggSum), map[string]float64{}); diff != "" {
+		t.Errorf("resampleVal(sum) got diff: %v", diff)
+	}
+	delete(val, "2020-02")
+	want = map[string]float64{"2020-02": 29}
+	if diff := cmp.Diff(r.resampleVal(val, aggSum), want); diff != "" {
+		t.Errorf("resampleVal(sum) got diff: %v", diff)
+	}
+}
+
+func TestResampleSeries(t *testing.T) {
+	r, err := newResampler(
+		context.Background(), nil, "Year", "sum", []string{"Count_Death"})
+	if err != nil {
+		t.Fatalf("newResampler() got error %v", err)
+	}
+	val := map[string]float64{"2019-12": 1}
+	for month := 1; month <= 12; month++ {
+		val[fmt.Sprintf("2020-%02d", month)] = 1
+	}
+	meta := &pb.StatMetadata{ImportName: "CDC", ObservationPeriod: "P1M"}
+	got := r.resampleSeries("Count_Death", &pb.Series{Val: val, Metadata: meta})
+	want := &pb.Series{
+		Val:      map[string]float64{"2020": 12},
+		Metadata: &pb.StatMetadata{ImportName: "CDC", ObservationPeriod: "P1Y"},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("resampleSeries() got diff: %v", diff)
+	}
+	if meta.ObservationPeriod != "P1M" {
+		t.Errorf("resampleSeries() should not modify the input metadata")
+	}
+
+	// Series without metadata, like some private data.
+	got = r.resampleSeries("Count_Death", &pb.Series{Val: val})
+	want.Metadata = &pb.StatMetadata{ObservationPeriod: "P1Y"}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("resampleSeries() got diff: %v", diff)
+	}
+
+	for _, c := range []struct {
+		resample    string
+		aggregation string
+	}{
+		{"day", "sum"},
+		{"year", "median"},
+		{"", "sum"},
+	} {
+		if _, err := newResampler(
+			context.Background(), nil, c.resample, c.aggregation, nil); err == nil {
+			t.Errorf("newResampler(%s, %s) should return error", c.resample, c.aggregation)
+		}
+	}
+}
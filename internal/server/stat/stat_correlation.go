@@ -0,0 +1,226 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	datePolicyLatest   = "latest"
+	datePolicySameYear = "same_year"
+	datePolicySameDate = "same_date"
+)
+
+// cohortDate returns the date of the value of a place in a cohort.
+func cohortDate(cohort *pb.SourceSeries, place, date string) string {
+	if date != "" {
+		return date
+	}
+	return cohort.PlaceToLatestDate[place]
+}
+
+// alignPoints pairs the values of the places in both cohorts, sorted by place.
+func alignPoints(x, y *pb.SourceSeries, date, datePolicy string) []*pb.ScatterPoint {
+	result := []*pb.ScatterPoint{}
+	for place, xVal := range x.Val {
+		yVal, ok := y.Val[place]
+		if !ok {
+			continue
+		}
+		point := &pb.ScatterPoint{
+			Place: place,
+			X:     xVal,
+			Y:     yVal,
+			XDate: cohortDate(x, place, date),
+			YDate: cohortDate(y, place, date),
+		}
+		switch datePolicy {
+		case datePolicySameDate:
+			if point.XDate != point.YDate {
+				continue
+			}
+		case datePolicySameYear:
+			if len(point.XDate) < 4 || len(point.YDate) < 4 ||
+				point.XDate[:4] != point.YDate[:4] {
+				continue
+			}
+		}
+		result = append(result, point)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Place < result[j].Place
+	})
+	return result
+}
+
+// pearson computes the Pearson correlation coefficient. Returns zero when
+// either variable has no variation.
+func pearson(x, y []float64) float64 {
+	n := float64(len(x))
+	meanX, meanY := 0.0, 0.0
+	for i := range x {
+		meanX += x[i]
+		meanY += y[i]
+	}
+	meanX /= n
+	meanY /= n
+	cov, varX, varY := 0.0, 0.0, 0.0
+	for i := range x {
+		cov += (x[i] - meanX) * (y[i] - meanY)
+		varX += (x[i] - meanX) * (x[i] - meanX)
+		varY += (y[i] - meanY) * (y[i] - meanY)
+	}
+	if varX == 0 || varY == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varX*varY)
+}
+
+// fractionalRanks ranks the values from 1, with tied values sharing their
+// average rank.
+func fractionalRanks(values []float64) []float64 {
+	index := make([]int, len(values))
+	for i := range index {
+		index[i] = i
+	}
+	sort.Slice(index, func(i, j int) bool {
+		return values[index[i]] < values[index[j]]
+	})
+	result := make([]float64, len(values))
+	for i := 0; i < len(index); {
+		// Values in [i, j) are tied.
+		j := i + 1
+		for j < len(index) && values[index[j]] == values[index[i]] {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			result[index[k]] = rank
+		}
+		i = j
+	}
+	return result
+}
+
+// linearFit computes the least squares fit of y = slope * x + intercept.
+func linearFit(x, y []float64) (float64, float64) {
+	n := float64(len(x))
+	meanX, meanY := 0.0, 0.0
+	for i := range x {
+		meanX += x[i]
+		meanY += y[i]
+	}
+	meanX /= n
+	meanY /= n
+	cov, varX := 0.0, 0.0
+	for i := range x {
+		cov += (x[i] - meanX) * (y[i] - meanY)
+		varX += (x[i] - meanX) * (x[i] - meanX)
+	}
+	if varX == 0 {
+		return 0, meanY
+	}
+	slope := cov / varX
+	return slope, meanY - slope*meanX
+}
+
+// GetStatCorrelation implements API for Mixer.GetStatCorrelation.
+func GetStatCorrelation(
+	ctx context.Context, in *pb.GetStatCorrelationRequest, store *store.Store) (
+	*pb.GetStatCorrelationResponse, error,
+) {
+	parentPlace := in.GetParentPlace()
+	childType := in.GetChildType()
+	statVarX := in.GetStatVarX()
+	statVarY := in.GetStatVarY()
+	date := in.GetDate()
+	if parentPlace == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: parent_place")
+	}
+	if childType == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: child_type")
+	}
+	if statVarX == "" || statVarY == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: stat_var_x, stat_var_y")
+	}
+	datePolicy := in.GetDatePolicy()
+	if datePolicy == "" {
+		datePolicy = datePolicyLatest
+	}
+	if datePolicy != datePolicyLatest &&
+		datePolicy != datePolicySameYear &&
+		datePolicy != datePolicySameDate {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Invalid date_policy: %s", datePolicy)
+	}
+
+	cohortsMap, err := readCohorts(ctx, store, parentPlace, childType, date,
+		[]string{statVarX, statVarY},
+		ranking.Options{
+			PreferredImports: in.GetPreferredImports(),
+			ExcludedImports:  in.GetExcludedImports(),
+		})
+	if err != nil {
+		return nil, err
+	}
+	for _, statVar := range []string{statVarX, statVarY} {
+		if _, ok := cohortsMap[statVar]; !ok {
+			return nil, status.Errorf(codes.NotFound,
+				"No data for %s of %s within %s", statVar, childType, parentPlace)
+		}
+	}
+	// Only use the best ranked cohort of each stat var, so the values of a stat
+	// var come from the same source.
+	cohortX := cohortsMap[statVarX][0]
+	cohortY := cohortsMap[statVarY][0]
+
+	result := &pb.GetStatCorrelationResponse{
+		Points:   alignPoints(cohortX, cohortY, date, datePolicy),
+		Metadata: map[uint32]*pb.StatMetadata{},
+	}
+	metaX := sourceSeriesMetadata(cohortX)
+	metaY := sourceSeriesMetadata(cohortY)
+	result.XMetaHash = getMetadataHash(metaX)
+	result.YMetaHash = getMetadataHash(metaY)
+	result.Metadata[result.XMetaHash] = metaX
+	result.Metadata[result.YMetaHash] = metaY
+	result.Count = int32(len(result.Points))
+	if len(result.Points) < 2 {
+		return result, nil
+	}
+	x := make([]float64, len(result.Points))
+	y := make([]float64, len(result.Points))
+	for i, p := range result.Points {
+		x[i] = p.X
+		y[i] = p.Y
+	}
+	result.Pearson = pearson(x, y)
+	result.Spearman = pearson(fractionalRanks(x), fractionalRanks(y))
+	result.Slope, result.Intercept = linearFit(x, y)
+	result.RSquared = result.Pearson * result.Pearson
+	return result, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestAlignPoints(t *testing.T) {
+	x := &pb.SourceSeries{
+		Val: map[string]float64{"geoId/01": 1, "geoId/02": 2, "geoId/03": 3, "geoId/04": 4},
+		PlaceToLatestDate: map[string]string{
+			"geoId/01": "2019", "geoId/02": "2019-06", "geoId/03": "2018", "geoId/04": "2019",
+		},
+	}
+	y := &pb.SourceSeries{
+		Val: map[string]float64{"geoId/01": 10, "geoId/02": 20, "geoId/03": 30},
+		PlaceToLatestDate: map[string]string{
+			"geoId/01": "2019", "geoId/02": "2019", "geoId/03": "2019",
+		},
+	}
+	for _, c := range []struct {
+		date       string
+		datePolicy string
+		want       []string
+	}{
+		{"", datePolicyLatest, []string{"geoId/01", "geoId/02", "geoId/03"}},
+		{"", datePolicySameYear, []string{"geoId/01", "geoId/02"}},
+		{"", datePolicySameDate, []string{"geoId/01"}},
+		{"2020", datePolicySameDate, []string{"geoId/01", "geoId/02", "geoId/03"}},
+	} {
+		got := []string{}
+		for _, p := range alignPoints(x, y, c.date, c.datePolicy) {
+			got = append(got, p.Place)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("alignPoints(%s, %s) got diff: %v", c.date, c.datePolicy, diff)
+		}
+	}
+
+	got := alignPoints(x, y, "", datePolicySameDate)
+	want := []*pb.ScatterPoint{
+		{Place: "geoId/01", X: 1, Y: 10, XDate: "2019", YDate: "2019"},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("alignPoints() got diff: %v", diff)
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	x := []float64{1, 2, 3, 4}
+	y := []float64{2, 4, 6, 9}
+	if got := pearson(x, y); math.Abs(got-0.9943767126843689) > 1e-9 {
+		t.Errorf("pearson() = %v, want 0.9943767126843689", got)
+	}
+	if got := pearson(x, []float64{5, 5, 5, 5}); got != 0 {
+		t.Errorf("pearson() = %v, want 0", got)
+	}
+	slope, intercept := linearFit(x, y)
+	if math.Abs(slope-2.3) > 1e-9 || math.Abs(intercept+0.5) > 1e-9 {
+		t.Errorf("linearFit() = %v, %v, want 2.3, -0.5", slope, intercept)
+	}
+
+	ranks := fractionalRanks([]float64{1, 2, 2, 3})
+	if diff := cmp.Diff(ranks, []float64{1, 2.5, 2.5, 4}); diff != "" {
+		t.Errorf("fractionalRanks() got diff: %v", diff)
+	}
+	spearman := pearson(ranks, fractionalRanks([]float64{4, 1, 3, 3}))
+	if math.Abs(spearman+0.5) > 1e-9 {
+		t.Errorf("spearman = %v, want -0.5", spearman)
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultNumBins = 10
+	maxNumBins     = 1000
+)
+
+var defaultQuantiles = []float64{0.25, 0.5, 0.75}
+
+// quantile computes the q quantile of sorted values, by linear interpolation
+// between the closest ranks.
+func quantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return sorted[lower] + (pos-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+// histogram counts the sorted values in numBins bins of equal width between the
+// min and max values. All the values are in a single bin when they are equal.
+func histogram(sorted []float64, numBins int) []*pb.StatDistribution_Bin {
+	min, max := sorted[0], sorted[len(sorted)-1]
+	if min == max {
+		return []*pb.StatDistribution_Bin{{Low: min, High: max, Count: int32(len(sorted))}}
+	}
+	width := (max - min) / float64(numBins)
+	result := make([]*pb.StatDistribution_Bin, numBins)
+	for i := range result {
+		result[i] = &pb.StatDistribution_Bin{
+			Low:  min + float64(i)*width,
+			High: min + float64(i+1)*width,
+		}
+	}
+	result[numBins-1].High = max
+	for _, v := range sorted {
+		i := int((v - min) / width)
+		if i >= numBins {
+			i = numBins - 1
+		}
+		result[i].Count++
+	}
+	return result
+}
+
+// computeDistribution computes the distribution of the place values.
+func computeDistribution(
+	values map[string]float64, numBins int, quantiles []float64,
+) *pb.StatDistribution {
+	sorted := make([]float64, 0, len(values))
+	for _, v := range values {
+		sorted = append(sorted, v)
+	}
+	sort.Float64s(sorted)
+	n := float64(len(sorted))
+	result := &pb.StatDistribution{
+		Count:  int32(len(sorted)),
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Median: quantile(sorted, 0.5),
+		Bins:   histogram(sorted, numBins),
+	}
+	for _, v := range sorted {
+		result.Mean += v
+	}
+	result.Mean /= n
+	variance := 0.0
+	for _, v := range sorted {
+		variance += (v - result.Mean) * (v - result.Mean)
+	}
+	result.Stddev = math.Sqrt(variance / n)
+	for _, q := range quantiles {
+		result.Quantiles = append(result.Quantiles, &pb.StatDistribution_Quantile{
+			Quantile: q,
+			Value:    quantile(sorted, q),
+		})
+	}
+	for place, v := range values {
+		if v == result.Min {
+			result.MinPlaces = append(result.MinPlaces, place)
+		}
+		if v == result.Max {
+			result.MaxPlaces = append(result.MaxPlaces, place)
+		}
+	}
+	sort.Strings(result.MinPlaces)
+	sort.Strings(result.MaxPlaces)
+	return result
+}
+
+// GetStatDistribution implements API for Mixer.GetStatDistribution.
+func GetStatDistribution(
+	ctx context.Context, in *pb.GetStatDistributionRequest, store *store.Store) (
+	*pb.GetStatDistributionResponse, error,
+) {
+	parentPlace := in.GetParentPlace()
+	statVars := in.GetStatVars()
+	childType := in.GetChildType()
+	if parentPlace == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: parent_place")
+	}
+	if len(statVars) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: stat_vars")
+	}
+	if childType == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: child_type")
+	}
+	numBins := int(in.GetNumBins())
+	if numBins < 0 || numBins > maxNumBins {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Invalid num_bins: %d, must be at most %d", numBins, maxNumBins)
+	}
+	if numBins == 0 {
+		numBins = defaultNumBins
+	}
+	quantiles := in.GetQuantiles()
+	for _, q := range quantiles {
+		// Also rejects NaN.
+		if !(q >= 0 && q <= 1) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid quantile: %v", q)
+		}
+	}
+	if len(quantiles) == 0 {
+		quantiles = defaultQuantiles
+	}
+
+	cohortsMap, err := readCohorts(ctx, store, parentPlace, childType, in.GetDate(), statVars,
+		ranking.Options{
+			PreferredImports: in.GetPreferredImports(),
+			ExcludedImports:  in.GetExcludedImports(),
+		})
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.GetStatDistributionResponse{
+		Data:     map[string]*pb.StatDistribution{},
+		Metadata: map[uint32]*pb.StatMetadata{},
+	}
+	for statVar, cohorts := range cohortsMap {
+		// Only use the best ranked cohort, so all the values come from the same
+		// source.
+		cohort := cohorts[0]
+		if len(cohort.Val) == 0 {
+			continue
+		}
+		metaData := sourceSeriesMetadata(cohort)
+		metaHash := getMetadataHash(metaData)
+		dist := computeDistribution(cohort.Val, numBins, quantiles)
+		dist.MetaHash = metaHash
+		result.Data[statVar] = dist
+		result.Metadata[metaHash] = metaData
+	}
+	return result, nil
+}
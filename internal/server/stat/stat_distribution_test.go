@@ -0,0 +1,120 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestComputeDistribution(t *testing.T) {
+	for _, c := range []struct {
+		values    map[string]float64
+		numBins   int
+		quantiles []float64
+		want      *pb.StatDistribution
+	}{
+		{
+			map[string]float64{"geoId/a": 1, "geoId/b": 2, "geoId/c": 3, "geoId/d": 4, "geoId/e": 4},
+			3,
+			[]float64{0.1, 0.75},
+			&pb.StatDistribution{
+				Count:  5,
+				Min:    1,
+				Max:    4,
+				Mean:   2.8,
+				Median: 3,
+				Stddev: math.Sqrt(1.36),
+				Quantiles: []*pb.StatDistribution_Quantile{
+					{Quantile: 0.1, Value: 1.4},
+					{Quantile: 0.75, Value: 4},
+				},
+				Bins: []*pb.StatDistribution_Bin{
+					{Low: 1, High: 2, Count: 1},
+					{Low: 2, High: 3, Count: 1},
+					{Low: 3, High: 4, Count: 3},
+				},
+				MinPlaces: []string{"geoId/a"},
+				MaxPlaces: []string{"geoId/d", "geoId/e"},
+			},
+		},
+		{
+			map[string]float64{"geoId/a": 7, "geoId/b": 7},
+			10,
+			[]float64{0.5},
+			&pb.StatDistribution{
+				Count:  2,
+				Min:    7,
+				Max:    7,
+				Mean:   7,
+				Median: 7,
+				Quantiles: []*pb.StatDistribution_Quantile{
+					{Quantile: 0.5, Value: 7},
+				},
+				Bins: []*pb.StatDistribution_Bin{
+					{Low: 7, High: 7, Count: 2},
+				},
+				MinPlaces: []string{"geoId/a", "geoId/b"},
+				MaxPlaces: []string{"geoId/a", "geoId/b"},
+			},
+		},
+	} {
+		got := computeDistribution(c.values, c.numBins, c.quantiles)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform(),
+			cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+			t.Errorf("computeDistribution(%v) got diff: %v", c.values, diff)
+		}
+	}
+}
+
+func TestGetStatDistributionNumBins(t *testing.T) {
+	for _, numBins := range []int32{-1, maxNumBins + 1, 2000000000} {
+		// The arguments are checked before reading the store.
+		_, err := GetStatDistribution(context.Background(), &pb.GetStatDistributionRequest{
+			ParentPlace: "geoId/06",
+			ChildType:   "County",
+			StatVars:    []string{"Count_Person"},
+			NumBins:     numBins,
+		}, nil)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetStatDistribution(num_bins=%d) got error %v, want InvalidArgument",
+				numBins, err)
+		}
+	}
+}
+
+func TestGetStatDistributionQuantiles(t *testing.T) {
+	for _, q := range []float64{-0.1, 1.5, math.NaN()} {
+		// The arguments are checked before reading the store.
+		_, err := GetStatDistribution(context.Background(), &pb.GetStatDistributionRequest{
+			ParentPlace: "geoId/06",
+			ChildType:   "County",
+			StatVars:    []string{"Count_Person"},
+			Quantiles:   []float64{q},
+		}, nil)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetStatDistribution(quantiles=%v) got error %v, want InvalidArgument",
+				q, err)
+		}
+	}
+}
@@ -51,6 +51,14 @@ func GetStatValue(ctx context.Context, in *pb.GetStatValueRequest, store *store.
 		Unit:    in.GetUnit(),
 		Sfactor: in.GetScalingFactor(),
 	}
+	transform, err := parseTransform(in.GetTransform())
+	if err != nil {
+		return nil, err
+	}
+	if transform != "" && in.GetBaseDate() == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: base_date")
+	}
 
 	rowList, keyTokens := bigtable.BuildObsTimeSeriesKey([]string{place}, []string{statVar})
 	var obsTimeSeries *model.ObsTimeSeries
@@ -64,16 +72,40 @@ func GetStatValue(ctx context.Context, in *pb.GetStatValueRequest, store *store.
 			codes.NotFound, "No data for %s, %s", place, statVar)
 	}
 	obsTimeSeries.SourceSeries = filterSeries(obsTimeSeries.SourceSeries, filterProp)
-	result, err := getValueFromBestSource(obsTimeSeries, date)
+	placeTypes, err := getRankingPlaceTypes(ctx, store, []string{place})
+	if err != nil {
+		return nil, err
+	}
+	opts := ranking.Options{
+		StatVar:          statVar,
+		PlaceType:        placeTypes[place],
+		PreferredImports: in.GetPreferredImports(),
+		ExcludedImports:  in.GetExcludedImports(),
+	}
+	var result float64
+	if transform != "" {
+		result, err = getChangeFromBestSource(
+			obsTimeSeries, date, in.GetBaseDate(), transform, opts)
+	} else {
+		result, err = getValueFromBestSource(obsTimeSeries, date, opts)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &pb.GetStatValueResponse{Value: result}, nil
 }
 
+// getStatSet gets the point stats of places and stat vars. When transform is
+// set, the stat is the change from baseDate to date. Sources are ranked with
+// the preferred and excluded imports of rankOpts.
 func getStatSet(
-	ctx context.Context, store *store.Store, places []string, statVars []string, date string) (
-	*pb.GetStatSetResponse, error) {
+	ctx context.Context,
+	store *store.Store,
+	places []string,
+	statVars []string,
+	date, baseDate, transform, targetUnit string,
+	rankOpts ranking.Options,
+) (*pb.GetStatSetResponse, error) {
 	// Initialize result with stat vars and place dcids.
 	ts := time.Now()
 	result := &pb.GetStatSetResponse{
@@ -94,6 +126,10 @@ func getStatSet(
 	if err != nil {
 		return nil, err
 	}
+	placeTypes, err := getRankingPlaceTypes(ctx, store, places)
+	if err != nil {
+		return nil, err
+	}
 	for _, place := range places {
 		placeData, ok := cacheData[place]
 		if !ok {
@@ -104,7 +140,17 @@ func getStatSet(
 			if !ok || data == nil {
 				continue
 			}
-			stat, metaData := getValueFromBestSourcePb(data, date)
+			data.SourceSeries = convertSourceSeriesPb(data.SourceSeries, targetUnit)
+			opts := rankOpts
+			opts.StatVar = statVar
+			opts.PlaceType = placeTypes[place]
+			var stat *pb.PointStat
+			var metaData *pb.StatMetadata
+			if transform != "" {
+				stat, metaData = getChangeFromBestSourcePb(data, date, baseDate, transform, opts)
+			} else {
+				stat, metaData = getValueFromBestSourcePb(data, date, opts)
+			}
 			if stat == nil {
 				continue
 			}
@@ -153,14 +199,7 @@ func getStatSetAll(
 				tmpResult[statVar] = map[uint32]*pb.PlacePointStat{}
 			}
 			for _, series := range ObsTimeSeries.SourceSeries {
-				metaData := &pb.StatMetadata{
-					ImportName:        series.ImportName,
-					ProvenanceUrl:     series.ProvenanceUrl,
-					MeasurementMethod: series.MeasurementMethod,
-					ObservationPeriod: series.ObservationPeriod,
-					ScalingFactor:     series.ScalingFactor,
-					Unit:              series.Unit,
-				}
+				metaData := sourceSeriesMetadata(series)
 				metaHash := getMetadataHash(metaData)
 				if _, ok := tmpResult[statVar][metaHash]; !ok {
 					tmpResult[statVar][metaHash] = &pb.PlacePointStat{
@@ -237,7 +276,72 @@ func GetStatSet(ctx context.Context, in *pb.GetStatSetRequest, store *store.Stor
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Missing required argument: stat_vars")
 	}
-	return getStatSet(ctx, store, places, statVars, date)
+	transform, err := parseTransform(in.GetTransform())
+	if err != nil {
+		return nil, err
+	}
+	if transform != "" && in.GetBaseDate() == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: base_date")
+	}
+	if transform != "" && in.GetDenominator() != "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"transform and denominator can not be both set")
+	}
+	rankOpts := ranking.Options{
+		PreferredImports: in.GetPreferredImports(),
+		ExcludedImports:  in.GetExcludedImports(),
+	}
+	result, err := getStatSet(
+		ctx, store, places, statVars, date, in.GetBaseDate(), transform,
+		in.GetTargetUnit(), rankOpts)
+	if err != nil {
+		return nil, err
+	}
+	if denominator := in.GetDenominator(); denominator != "" {
+		if err := applyDenominatorToStatSet(
+			ctx, store, result, denominator, rankOpts); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// readCohorts reads the obs collection of the child places of a type within a
+// parent place at a date, or the latest date when date is empty. The source
+// cohorts of each stat var are sorted from the best ranked, and stat vars
+// without data are not in the result.
+func readCohorts(
+	ctx context.Context,
+	store *store.Store,
+	parentPlace, childType, date string,
+	statVars []string,
+	rankOpts ranking.Options,
+) (map[string][]*pb.SourceSeries, error) {
+	dateKey := date
+	if date == "" {
+		dateKey = "LATEST"
+	}
+	rowList, keyTokens := bigtable.BuildObsCollectionKey(parentPlace, childType, dateKey, statVars)
+	cacheData, err := bigtable.ReadStatCollection(ctx, store.BtGroup, rowList, keyTokens)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string][]*pb.SourceSeries{}
+	for _, statVar := range statVars {
+		data, ok := cacheData[statVar]
+		if !ok || data == nil {
+			continue
+		}
+		opts := rankOpts
+		opts.StatVar = statVar
+		opts.PlaceType = childType
+		cohorts := ranking.SortCohorts(data.SourceCohorts, opts)
+		if len(cohorts) > 0 {
+			result[statVar] = cohorts
+		}
+	}
+	return result, nil
 }
 
 // GetStatSetWithinPlace implements API for Mixer.GetStatSetWithinPlace.
@@ -257,23 +361,38 @@ func GetStatSetWithinPlace(
 		childType,
 		date,
 	)
-	if parentPlace == "" {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"Missing required argument: parent_place")
-	}
 	if len(statVars) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Missing required argument: stat_vars")
 	}
-	if childType == "" {
+	// Places of a selector that can not be read from a single collection are
+	// resolved up front, and read per place.
+	var childPlaces []string
+	var err error
+	useCollection := true
+	if selector := in.GetPlaceSelector(); selector != nil {
+		var ok bool
+		parentPlace, childType, ok = place.SingleCollection(selector)
+		if !ok {
+			childPlaces, err = place.ResolvePlaceSelector(ctx, store, selector)
+			if err != nil {
+				return nil, err
+			}
+			useCollection = false
+		}
+	}
+	if useCollection && parentPlace == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Missing required argument: parent_place")
+	}
+	if useCollection && childType == "" {
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Missing required argument: child_type")
 	}
-	dateKey := date
-	if date == "" {
-		dateKey = "LATEST"
+	rankOpts := ranking.Options{
+		PreferredImports: in.GetPreferredImports(),
+		ExcludedImports:  in.GetExcludedImports(),
 	}
-
 	// Pre-populate result
 	result := &pb.GetStatSetResponse{
 		Data:     make(map[string]*pb.PlacePointStat),
@@ -286,32 +405,26 @@ func GetStatSetWithinPlace(
 	}
 
 	// Read from cache directly
-	rowList, keyTokens := bigtable.BuildObsCollectionKey(parentPlace, childType, dateKey, statVars)
-	cacheData, err := bigtable.ReadStatCollection(ctx, store.BtGroup, rowList, keyTokens)
-	if err != nil {
-		return nil, err
+	cohortsMap := map[string][]*pb.SourceSeries{}
+	if useCollection {
+		cohortsMap, err = readCohorts(
+			ctx, store, parentPlace, childType, date, statVars, rankOpts)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	gotResult := false
 	for _, statVar := range statVars {
-		data, ok := cacheData[statVar]
-		if !ok || data == nil {
+		cohorts, ok := cohortsMap[statVar]
+		if !ok {
 			continue
 		}
 		gotResult = true
-		cohorts := data.SourceCohorts
-		// Sort cohort first, so the preferred source is populated first.
-		sort.Sort(ranking.SeriesByRank(cohorts))
+		// Cohorts are sorted, so the preferred source is populated first.
 		// update when there is a later data.
 		for _, cohort := range cohorts {
-			metaData := &pb.StatMetadata{
-				MeasurementMethod: cohort.MeasurementMethod,
-				ObservationPeriod: cohort.ObservationPeriod,
-				ProvenanceUrl:     cohort.ProvenanceUrl,
-				ScalingFactor:     cohort.ScalingFactor,
-				ImportName:        cohort.ImportName,
-				Unit:              cohort.Unit,
-			}
+			metaData := sourceSeriesMetadata(cohort)
 			for place, val := range cohort.Val {
 				pointStat, ok := result.Data[statVar].Stat[place]
 				// This works when date is set. The result will be populated in first
@@ -342,8 +455,7 @@ func GetStatSetWithinPlace(
 	}
 	// Need to fetch child places if need to read data from memory database or
 	// from per place,statvar bigtable.
-	var childPlaces []string
-	if !gotResult || statVarInMemDb {
+	if useCollection && (!gotResult || statVarInMemDb) {
 		childPlaces, err = place.GetChildPlaces(ctx, store, parentPlace, childType)
 		if err != nil {
 			return nil, err
@@ -351,7 +463,8 @@ func GetStatSetWithinPlace(
 	}
 	// No data found from cache, fetch stat series for each place separately.
 	if !gotResult {
-		result, err = getStatSet(ctx, store, childPlaces, statVars, date)
+		result, err = getStatSet(
+			ctx, store, childPlaces, statVars, date, "", "", "", rankOpts)
 		if err != nil {
 			return nil, err
 		}
@@ -373,6 +486,12 @@ func GetStatSetWithinPlace(
 		}
 	}
 
+	if denominator := in.GetDenominator(); denominator != "" {
+		if err := applyDenominatorToStatSet(
+			ctx, store, result, denominator, rankOpts); err != nil {
+			return nil, err
+		}
+	}
 	return result, nil
 }
 
@@ -436,14 +555,7 @@ func GetStatSetWithinPlaceAll(
 		gotResult = true
 		for _, cohort := range data.SourceCohorts {
 			// The cohort is from the same source.
-			metaData := &pb.StatMetadata{
-				MeasurementMethod: cohort.MeasurementMethod,
-				ObservationPeriod: cohort.ObservationPeriod,
-				ProvenanceUrl:     cohort.ProvenanceUrl,
-				ScalingFactor:     cohort.ScalingFactor,
-				ImportName:        cohort.ImportName,
-				Unit:              cohort.Unit,
-			}
+			metaData := sourceSeriesMetadata(cohort)
 			metaHash := getMetadataHash(metaData)
 			pointStat := &pb.PlacePointStat{
 				MetaHash: metaHash,
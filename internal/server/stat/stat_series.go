@@ -19,7 +19,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"sort"
 	"time"
 
 	cbt "cloud.google.com/go/bigtable"
@@ -62,6 +61,24 @@ func GetStatSeries(
 		Unit:    in.GetUnit(),
 		Sfactor: in.GetScalingFactor(),
 	}
+	dateFilter, err := newDateFilter(
+		in.GetStartDate(), in.GetEndDate(), in.GetGranularity())
+	if err != nil {
+		return nil, err
+	}
+	resampler, err := newResampler(
+		ctx, store, in.GetResample(), in.GetAggregation(), []string{statVar})
+	if err != nil {
+		return nil, err
+	}
+	fill, err := parseFill(in.GetFill())
+	if err != nil {
+		return nil, err
+	}
+	transform, err := parseTransform(in.GetTransform())
+	if err != nil {
+		return nil, err
+	}
 
 	rowList, keyTokens := bigtable.BuildObsTimeSeriesKey([]string{place}, []string{statVar})
 	btData, err := bigtable.ReadStats(ctx, store.BtGroup, rowList, keyTokens)
@@ -75,10 +92,30 @@ func GetStatSeries(
 	}
 	series := obsTimeSeries.SourceSeries
 	series = filterSeries(series, filterProp)
-	sort.Sort(ranking.ByRank(series))
+	series = convertSourceSeries(series, in.GetTargetUnit())
+	placeTypes, err := getRankingPlaceTypes(ctx, store, []string{place})
+	if err != nil {
+		return nil, err
+	}
+	opts := ranking.Options{
+		StatVar:          statVar,
+		PlaceType:        placeTypes[place],
+		PreferredImports: in.GetPreferredImports(),
+		ExcludedImports:  in.GetExcludedImports(),
+	}
+	series = ranking.Sort(series, opts)
 	resp := pb.GetStatSeriesResponse{Series: map[string]float64{}}
 	if len(series) > 0 {
-		resp.Series = series[0].Val
+		val := series[0].Val
+		if resampler != nil {
+			val = resampler.resampleVal(val, resampler.method(statVar))
+		}
+		val, filled := fillGaps(val, fill)
+		if transform != "" {
+			val = transformVal(val, transform, int(in.GetTransformPeriods()))
+		}
+		resp.Series = dateFilter.filterVal(val)
+		resp.FilledDates = keepFilledDates(filled, resp.Series)
 	}
 	return &resp, nil
 }
@@ -97,6 +134,16 @@ func GetStatAll(ctx context.Context, in *pb.GetStatAllRequest, store *store.Stor
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Missing required argument: stat_var")
 	}
+	dateFilter, err := newDateFilter(
+		in.GetStartDate(), in.GetEndDate(), in.GetGranularity())
+	if err != nil {
+		return nil, err
+	}
+	resampler, err := newResampler(
+		ctx, store, in.GetResample(), in.GetAggregation(), statVars)
+	if err != nil {
+		return nil, err
+	}
 
 	// Initialize result with place and stat var dcids.
 	result := &pb.GetStatAllResponse{
@@ -116,10 +163,22 @@ func GetStatAll(ctx context.Context, in *pb.GetStatAllRequest, store *store.Stor
 	if err != nil {
 		return nil, err
 	}
+	placeTypes, err := getRankingPlaceTypes(ctx, store, places)
+	if err != nil {
+		return nil, err
+	}
 	for place, placeData := range cacheData {
 		for statVar, data := range placeData {
 			if data != nil && data.SourceSeries != nil {
-				sort.Sort(ranking.SeriesByRank(data.SourceSeries))
+				resampler.resampleSourceSeries(statVar, data.SourceSeries)
+				data.SourceSeries = dateFilter.filterSourceSeries(data.SourceSeries)
+				data.SourceSeries = ranking.SortSeries(data.SourceSeries,
+					ranking.Options{
+						StatVar:          statVar,
+						PlaceType:        placeTypes[place],
+						PreferredImports: in.GetPreferredImports(),
+						ExcludedImports:  in.GetExcludedImports(),
+					})
 			}
 			result.PlaceData[place].StatVarData[statVar] = data
 		}
@@ -172,8 +231,13 @@ func GetStats(ctx context.Context, in *pb.GetStatsRequest, store *store.Store) (
 			result[dcid] = nil
 		}
 	}
-	for _, obsSeries := range result {
-		FilterAndRank(obsSeries, filterProp)
+	placeTypes, err := getRankingPlaceTypes(ctx, store, placeDcids)
+	if err != nil {
+		return nil, err
+	}
+	for place, obsSeries := range result {
+		FilterAndRank(obsSeries, filterProp,
+			ranking.Options{StatVar: statsVarDcid, PlaceType: placeTypes[place]})
 	}
 	jsonRaw, err := json.Marshal(result)
 	if err != nil {
@@ -182,6 +246,33 @@ func GetStats(ctx context.Context, in *pb.GetStatsRequest, store *store.Store) (
 	return &pb.GetStatsResponse{Payload: string(jsonRaw)}, nil
 }
 
+// seriesPipeline post-processes a chosen series in the order of resample, gap
+// filling, denominator, transform and date filter.
+type seriesPipeline struct {
+	resampler *resampler
+	fill      string
+	// Denominator series keyed by place, nil without denominator.
+	denoms     map[string]*pb.Series
+	transform  string
+	periods    int
+	dateFilter *dateFilter
+}
+
+// process post-processes a series of a place. Returns nil if there is no value
+// left, or no denominator series for the place.
+func (p *seriesPipeline) process(place, statVar string, series *pb.Series) *pb.Series {
+	series = fillSeries(p.resampler.resampleSeries(statVar, series), p.fill)
+	if p.denoms != nil && series != nil {
+		denom, ok := p.denoms[place]
+		if !ok {
+			return nil
+		}
+		series = divideSeries(series, denom)
+	}
+	series = transformSeries(series, p.transform, p.periods)
+	return p.dateFilter.filterSeries(series)
+}
+
 // GetStatSetSeries implements API for Mixer.GetStatSetSeries.
 func GetStatSetSeries(ctx context.Context, in *pb.GetStatSetSeriesRequest, store *store.Store) (
 	*pb.GetStatSetSeriesResponse, error) {
@@ -196,10 +287,41 @@ func GetStatSetSeries(ctx context.Context, in *pb.GetStatSetSeriesRequest, store
 		return nil, status.Errorf(
 			codes.InvalidArgument, "Missing required argument: stat_vars")
 	}
-
+	dateFilter, err := newDateFilter(
+		in.GetStartDate(), in.GetEndDate(), in.GetGranularity())
+	if err != nil {
+		return nil, err
+	}
+	resampler, err := newResampler(
+		ctx, store, in.GetResample(), in.GetAggregation(), statVars)
+	if err != nil {
+		return nil, err
+	}
+	fill, err := parseFill(in.GetFill())
+	if err != nil {
+		return nil, err
+	}
+	transform, err := parseTransform(in.GetTransform())
+	if err != nil {
+		return nil, err
+	}
+	metaFilter, err := newMetadataFilter(in)
+	if err != nil {
+		return nil, err
+	}
+	allSources := in.GetAllSources()
+	if allSources && in.GetStitch() {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"stitch and all_sources can not be both set")
+	}
+	targetUnit := in.GetTargetUnit()
 	// Initialize result with place and stat var dcids.
 	result := &pb.GetStatSetSeriesResponse{
-		Data: make(map[string]*pb.SeriesMap),
+		Data:     make(map[string]*pb.SeriesMap),
+		Metadata: make(map[uint32]*pb.StatMetadata),
+	}
+	if allSources {
+		result.AllData = make(map[string]*pb.SeriesListMap)
 	}
 	for _, place := range places {
 		result.Data[place] = &pb.SeriesMap{
@@ -208,7 +330,50 @@ func GetStatSetSeries(ctx context.Context, in *pb.GetStatSetSeriesRequest, store
 		for _, statVar := range statVars {
 			result.Data[place].Data[statVar] = nil
 		}
+		if allSources {
+			result.AllData[place] = &pb.SeriesListMap{
+				Data: make(map[string]*pb.SeriesList),
+			}
+		}
+	}
+	// The denominator series are read first, so the transform is computed on
+	// the ratios.
+	pipeline := &seriesPipeline{
+		resampler:  resampler,
+		fill:       fill,
+		transform:  transform,
+		periods:    int(in.GetTransformPeriods()),
+		dateFilter: dateFilter,
 	}
+	if denominator := in.GetDenominator(); denominator != "" {
+		pipeline.denoms, err = readDenominators(ctx, store, places, denominator,
+			ranking.Options{
+				PreferredImports: in.GetPreferredImports(),
+				ExcludedImports:  in.GetExcludedImports(),
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Add one series of all sources, with metadata moved to the metadata map.
+	addSource := func(place, statVar string, series *pb.Series) {
+		meta := series.Metadata
+		series = pipeline.process(place, statVar, series)
+		if series == nil {
+			return
+		}
+		metaHash := getMetadataHash(meta)
+		result.Metadata[metaHash] = meta
+		series.Metadata = nil
+		series.MetaHash = metaHash
+		seriesListMap := result.AllData[place]
+		if seriesListMap.Data[statVar] == nil {
+			seriesListMap.Data[statVar] = &pb.SeriesList{}
+		}
+		seriesListMap.Data[statVar].Series = append(
+			seriesListMap.Data[statVar].Series, series)
+	}
+
 	// Read data from Cloud Bigtable.
 	if store.BtGroup.BaseBt() != nil {
 		rowList, keyTokens := bigtable.BuildObsTimeSeriesKey(places, statVars)
@@ -218,11 +383,43 @@ func GetStatSetSeries(ctx context.Context, in *pb.GetStatSetSeriesRequest, store
 		if err != nil {
 			return nil, err
 		}
+		placeTypes, err := getRankingPlaceTypes(ctx, store, places)
+		if err != nil {
+			return nil, err
+		}
 		for place, placeData := range cacheData {
 			for statVar, data := range placeData {
 				if data != nil {
-					series, _ := GetBestSeries(data, importName, false /* useLatest */)
-					result.Data[place].Data[statVar] = series
+					opts := ranking.Options{
+						StatVar:          statVar,
+						PlaceType:        placeTypes[place],
+						PreferredImports: in.GetPreferredImports(),
+						ExcludedImports:  in.GetExcludedImports(),
+					}
+					data.SourceSeries = convertSourceSeriesPb(
+						metaFilter.filterSourceSeries(data.SourceSeries), targetUnit)
+					if allSources {
+						data.SourceSeries = ranking.SortSeries(data.SourceSeries, opts)
+						for _, source := range data.SourceSeries {
+							if importName != "" && source.ImportName != importName {
+								continue
+							}
+							addSource(place, statVar, rawSeriesToSeries(source))
+						}
+						continue
+					}
+					var series *pb.Series
+					if in.GetStitch() && importName == "" {
+						var metadata map[uint32]*pb.StatMetadata
+						series, metadata = getStitchedSeries(
+							data, opts, in.GetStitchMeasurementMethods())
+						for metaHash, meta := range metadata {
+							result.Metadata[metaHash] = meta
+						}
+					} else {
+						series, _ = GetBestSeries(data, importName, false /* useLatest */, opts)
+					}
+					result.Data[place].Data[statVar] = pipeline.process(place, statVar, series)
 				}
 			}
 		}
@@ -231,13 +428,59 @@ func GetStatSetSeries(ctx context.Context, in *pb.GetStatSetSeriesRequest, store
 	// When there is data in both BigTable and private data. Prefer private data
 	// as this instance is for a private DC.
 	if !store.MemDb.IsEmpty() {
+		// Resampling, gap filling and transform need the full series, the date
+		// range is applied after.
+		startDate, endDate := in.GetStartDate(), in.GetEndDate()
+		if resampler != nil || fill != fillNone || transform != "" {
+			startDate, endDate = "", ""
+		}
 		for _, place := range places {
 			for _, statVar := range statVars {
-				series := store.MemDb.ReadSeries(statVar, place)
-				if len(series) > 0 {
-					// TODO: add ranking function for *pb.Series. Now only pick one series
-					// from the private import.
-					result.Data[place].Data[statVar] = series[0]
+				series := []*pb.Series{}
+				for _, s := range store.MemDb.ReadSeriesInRange(
+					statVar, place, startDate, endDate) {
+					if importName != "" && s.GetMetadata().GetImportName() != importName {
+						continue
+					}
+					if !metaFilter.match(s.Metadata) {
+						continue
+					}
+					if s = convertSeries(s, targetUnit); s != nil {
+						series = append(series, s)
+					}
+				}
+				if allSources {
+					for _, s := range series {
+						addSource(place, statVar, s)
+					}
+					continue
+				}
+				if len(series) == 0 {
+					continue
+				}
+				// TODO: add ranking function for *pb.Series. Now only pick one series
+				// from the private import.
+				chosen := series[0]
+				if in.GetStitch() && importName == "" {
+					rawSeries := []*pb.SourceSeries{}
+					for _, s := range series {
+						rawSeries = append(rawSeries, seriesToRawSeries(s))
+					}
+					var metadata map[uint32]*pb.StatMetadata
+					chosen, metadata = getStitchedSeries(
+						&pb.ObsTimeSeries{SourceSeries: rawSeries},
+						ranking.Options{
+							StatVar:          statVar,
+							PreferredImports: in.GetPreferredImports(),
+							ExcludedImports:  in.GetExcludedImports(),
+						},
+						in.GetStitchMeasurementMethods())
+					for metaHash, meta := range metadata {
+						result.Metadata[metaHash] = meta
+					}
+				}
+				if processed := pipeline.process(place, statVar, chosen); processed != nil {
+					result.Data[place].Data[statVar] = processed
 				}
 			}
 		}
@@ -253,28 +496,69 @@ func GetStatSetSeriesWithinPlace(
 	parentPlace := in.GetParentPlace()
 	statVars := in.GetStatVars()
 	childType := in.GetChildType()
-	if parentPlace == "" {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"Missing required argument: parent_place")
-	}
 	if len(statVars) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Missing required argument: stat_vars")
 	}
-	if childType == "" {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"Missing required argument: child_type")
-	}
-	childPlaces, err := place.GetChildPlaces(ctx, store, parentPlace, childType)
-	if err != nil {
-		return nil, err
+	var childPlaces []string
+	var err error
+	if selector := in.GetPlaceSelector(); selector != nil {
+		childPlaces, err = place.ResolvePlaceSelector(ctx, store, selector)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if parentPlace == "" {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Missing required argument: parent_place")
+		}
+		if childType == "" {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Missing required argument: child_type")
+		}
+		childPlaces, err = place.GetChildPlaces(ctx, store, parentPlace, childType)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	if len(childPlaces) == 0 {
+		// Same as the response of GetStatSetSeries without data.
+		result := &pb.GetStatSetSeriesResponse{
+			Data:     map[string]*pb.SeriesMap{},
+			Metadata: map[uint32]*pb.StatMetadata{},
+		}
+		if in.GetAllSources() {
+			result.AllData = map[string]*pb.SeriesListMap{}
+		}
+		return result, nil
+	}
 	return GetStatSetSeries(
 		ctx,
 		&pb.GetStatSetSeriesRequest{
-			Places:   childPlaces,
-			StatVars: statVars,
+			Places:                   childPlaces,
+			StatVars:                 statVars,
+			ImportName:               in.GetImportName(),
+			StartDate:                in.GetStartDate(),
+			EndDate:                  in.GetEndDate(),
+			Granularity:              in.GetGranularity(),
+			Denominator:              in.GetDenominator(),
+			Resample:                 in.GetResample(),
+			Aggregation:              in.GetAggregation(),
+			Fill:                     in.GetFill(),
+			Transform:                in.GetTransform(),
+			TransformPeriods:         in.GetTransformPeriods(),
+			Stitch:                   in.GetStitch(),
+			StitchMeasurementMethods: in.GetStitchMeasurementMethods(),
+			AllSources:               in.GetAllSources(),
+			MeasurementMethod:        in.GetMeasurementMethod(),
+			ObservationPeriod:        in.GetObservationPeriod(),
+			Unit:                     in.GetUnit(),
+			ScalingFactor:            in.GetScalingFactor(),
+			IsDcAggregate:            in.GetIsDcAggregate(),
+			TargetUnit:               in.GetTargetUnit(),
+			PreferredImports:         in.GetPreferredImports(),
+			ExcludedImports:          in.GetExcludedImports(),
 		},
 		store,
 	)
@@ -0,0 +1,62 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetStatSetSeriesWithinPlaceEmpty(t *testing.T) {
+	// The selector has no place left, so the store is not read.
+	got, err := GetStatSetSeriesWithinPlace(
+		context.Background(),
+		&pb.GetStatSetSeriesWithinPlaceRequest{
+			StatVars: []string{"Count_Person"},
+			PlaceSelector: &pb.PlaceSelector{
+				Places:         []string{"geoId/06"},
+				ExcludedPlaces: []string{"geoId/06"},
+			},
+			AllSources: true,
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("GetStatSetSeriesWithinPlace() got error %v", err)
+	}
+	if got.Data == nil || got.Metadata == nil || got.AllData == nil {
+		t.Errorf("GetStatSetSeriesWithinPlace() = %v, want empty data, metadata and all data", got)
+	}
+}
+
+func TestGetStatSetSeriesStitchAllSources(t *testing.T) {
+	_, err := GetStatSetSeries(
+		context.Background(),
+		&pb.GetStatSetSeriesRequest{
+			Places:     []string{"geoId/06"},
+			StatVars:   []string{"Count_Person"},
+			Stitch:     true,
+			AllSources: true,
+		},
+		nil,
+	)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetStatSetSeries() got error %v, want InvalidArgument", err)
+	}
+}
@@ -142,7 +142,7 @@ func TestFilterAndRank(t *testing.T) {
 		FilterAndRank(got, &model.ObsProp{
 			Mmethod: c.mmethod,
 			Operiod: c.op,
-			Unit:    c.unit})
+			Unit:    c.unit}, ranking.Options{})
 		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
 			t.Errorf("filterAndRank() got diff %+v", diff)
 		}
@@ -220,7 +220,7 @@ func TestGetLatest(t *testing.T) {
 			200,
 		},
 	} {
-		value, _ := getValueFromBestSource(obsTimeSeries, c.date)
+		value, _ := getValueFromBestSource(obsTimeSeries, c.date, ranking.Options{})
 		if c.want != value {
 			t.Errorf("Wrong latest value %f", value)
 		}
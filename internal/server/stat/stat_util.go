@@ -16,7 +16,6 @@ package stat
 
 import (
 	"hash/fnv"
-	"sort"
 	"strings"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
@@ -55,12 +54,12 @@ func filterSeries(in []*model.SourceSeries, prop *model.ObsProp) []*model.Source
 }
 
 // FilterAndRank filters and ranks ObsTimeSeries in place.
-func FilterAndRank(in *model.ObsTimeSeries, prop *model.ObsProp) {
+func FilterAndRank(in *model.ObsTimeSeries, prop *model.ObsProp, opts ranking.Options) {
 	if in == nil {
 		return
 	}
 	series := filterSeries(in.SourceSeries, prop)
-	sort.Sort(ranking.ByRank(series))
+	series = ranking.Sort(series, opts)
 	if len(series) > 0 {
 		in.Data = series[0].Val
 		in.ProvenanceURL = series[0].ProvenanceURL
@@ -79,6 +78,7 @@ func GetBestSeries(
 	in *pb.ObsTimeSeries,
 	importName string,
 	useLatest bool,
+	opts ranking.Options,
 ) (*pb.Series, *string) {
 	rawSeries := in.SourceSeries
 	// If importName is set, must return the series with that import name.
@@ -90,7 +90,7 @@ func GetBestSeries(
 		}
 		return nil, nil
 	}
-	sort.Sort(ranking.SeriesByRank(rawSeries))
+	rawSeries = ranking.SortSeries(rawSeries, opts)
 	if len(rawSeries) > 0 {
 		// Choose the latest series.
 		if useLatest {
@@ -115,16 +115,84 @@ func GetBestSeries(
 	return nil, nil
 }
 
+// seriesToRawSeries converts a series to a source series, for ranking and
+// stitching series of the memory database.
+func seriesToRawSeries(in *pb.Series) *pb.SourceSeries {
+	meta := in.GetMetadata()
+	return &pb.SourceSeries{
+		Val:               in.Val,
+		ImportName:        meta.GetImportName(),
+		ProvenanceUrl:     meta.GetProvenanceUrl(),
+		MeasurementMethod: meta.GetMeasurementMethod(),
+		ObservationPeriod: meta.GetObservationPeriod(),
+		ScalingFactor:     meta.GetScalingFactor(),
+		Unit:              meta.GetUnit(),
+		IsDcAggregate:     meta.GetIsDcAggregate(),
+	}
+}
+
 func rawSeriesToSeries(in *pb.SourceSeries) *pb.Series {
 	result := &pb.Series{}
 	result.Val = in.Val
-	result.Metadata = &pb.StatMetadata{
-		ImportName:        in.ImportName,
-		ProvenanceUrl:     in.ProvenanceUrl,
-		MeasurementMethod: in.MeasurementMethod,
-		ObservationPeriod: in.ObservationPeriod,
-		ScalingFactor:     in.ScalingFactor,
-		Unit:              in.Unit,
+	result.Metadata = sourceSeriesMetadata(in)
+	return result
+}
+
+// getStitchedSeries merges the source series in ranking order. Dates missing
+// in the top ranked series are filled from lower ranked series that have the
+// same unit, scaling factor, observation period and, unless mixMethods is set,
+// measurement method, so values are comparable.
+//
+// The metadata hash of each date is set in the result, and the second return
+// value holds the metadata of the used sources keyed by hash.
+func getStitchedSeries(in *pb.ObsTimeSeries, opts ranking.Options, mixMethods bool) (
+	*pb.Series, map[uint32]*pb.StatMetadata) {
+	rawSeries := ranking.SortSeries(in.SourceSeries, opts)
+	if len(rawSeries) == 0 {
+		return nil, nil
+	}
+	top := rawSeries[0]
+	result := rawSeriesToSeries(top)
+	result.Val = map[string]float64{}
+	result.MetaHashes = map[string]uint32{}
+	metadata := map[uint32]*pb.StatMetadata{}
+	for _, series := range rawSeries {
+		if series.Unit != top.Unit || series.ScalingFactor != top.ScalingFactor ||
+			series.ObservationPeriod != top.ObservationPeriod {
+			continue
+		}
+		if !mixMethods && series.MeasurementMethod != top.MeasurementMethod {
+			continue
+		}
+		meta := rawSeriesToSeries(series).Metadata
+		metaHash := getMetadataHash(meta)
+		used := false
+		for date, value := range series.Val {
+			if _, ok := result.Val[date]; ok {
+				continue
+			}
+			result.Val[date] = value
+			result.MetaHashes[date] = metaHash
+			used = true
+		}
+		if used {
+			metadata[metaHash] = meta
+		}
+	}
+	return result, metadata
+}
+
+// keepMetaHashes returns the date metadata hashes of the dates that are still
+// in the series.
+func keepMetaHashes(hashes map[string]uint32, val map[string]float64) map[string]uint32 {
+	if len(hashes) == 0 {
+		return nil
+	}
+	result := map[string]uint32{}
+	for date, hash := range hashes {
+		if _, ok := val[date]; ok {
+			result[date] = hash
+		}
 	}
 	return result
 }
@@ -136,12 +204,13 @@ func rawSeriesToSeries(in *pb.SourceSeries) *pb.Series {
 //
 // When date is not given, it get the latest value from the highest ranked
 // source series.
-func getValueFromBestSource(in *model.ObsTimeSeries, date string) (float64, error) {
+func getValueFromBestSource(
+	in *model.ObsTimeSeries, date string, opts ranking.Options) (float64, error) {
 	if in == nil {
 		return 0, status.Error(codes.Internal, "Nil obs time series for getValueFromBestSource()")
 	}
 	sourceSeries := in.SourceSeries
-	sort.Sort(ranking.ByRank(sourceSeries))
+	sourceSeries = ranking.Sort(sourceSeries, opts)
 	if date != "" {
 		for _, series := range sourceSeries {
 			if value, ok := series.Val[date]; ok {
@@ -175,25 +244,19 @@ func getValueFromBestSource(in *model.ObsTimeSeries, date string) (float64, erro
 // When date is not given, it get the latest value from all the source series.
 // If two sources has the same latest date, the highest ranked source is preferred.
 func getValueFromBestSourcePb(
-	in *pb.ObsTimeSeries, date string) (*pb.PointStat, *pb.StatMetadata) {
+	in *pb.ObsTimeSeries, date string, opts ranking.Options,
+) (*pb.PointStat, *pb.StatMetadata) {
 	if in == nil {
 		return nil, nil
 	}
 	sourceSeries := in.SourceSeries
-	sort.Sort(ranking.SeriesByRank(sourceSeries))
+	sourceSeries = ranking.SortSeries(sourceSeries, opts)
 
 	// Date is given, get the value from highest ranked source that has this date.
 	if date != "" {
 		for _, series := range sourceSeries {
 			if value, ok := series.Val[date]; ok {
-				meta := &pb.StatMetadata{
-					ImportName:        series.ImportName,
-					ProvenanceUrl:     series.ProvenanceUrl,
-					MeasurementMethod: series.MeasurementMethod,
-					ObservationPeriod: series.ObservationPeriod,
-					ScalingFactor:     series.ScalingFactor,
-					Unit:              series.Unit,
-				}
+				meta := sourceSeriesMetadata(series)
 				return &pb.PointStat{
 					Date:  date,
 					Value: value,
@@ -214,14 +277,7 @@ func getValueFromBestSourcePb(
 		if idx > 0 && lowQualityPopulationImport(series.ImportName) {
 			break
 		}
-		meta = &pb.StatMetadata{
-			ImportName:        series.ImportName,
-			ProvenanceUrl:     series.ProvenanceUrl,
-			MeasurementMethod: series.MeasurementMethod,
-			ObservationPeriod: series.ObservationPeriod,
-			ScalingFactor:     series.ScalingFactor,
-			Unit:              series.Unit,
-		}
+		meta = sourceSeriesMetadata(series)
 		for date, value := range series.Val {
 			if date > latestDate {
 				latestDate = date
@@ -242,13 +298,18 @@ func getValueFromBestSourcePb(
 // Note this should be restrict to a request scope.
 func getMetadataHash(m *pb.StatMetadata) uint32 {
 	h := fnv.New32a()
-	_, _ = h.Write([]byte(strings.Join([]string{
+	parts := []string{
 		m.ImportName,
 		m.MeasurementMethod,
 		m.ObservationPeriod,
 		m.ObservationPeriod,
 		m.ScalingFactor,
 		m.Unit,
-	}, "-")))
+	}
+	// Only added when set, so hashes of imported data are unchanged.
+	if m.IsDcAggregate {
+		parts = append(parts, "dcAggregate")
+	}
+	_, _ = h.Write([]byte(strings.Join(parts, "-")))
 	return h.Sum32()
 }
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
 )
@@ -110,8 +111,31 @@ func TestGetValueFromBestSourcePb(t *testing.T) {
 				MeasurementMethod: "CensusACS5yrSurvey",
 			},
 		},
+		{
+			// Aggregated by DC
+			&pb.ObsTimeSeries{
+				SourceSeries: []*pb.SourceSeries{
+					{
+						Val: map[string]float64{
+							"2019": 300,
+						},
+						ImportName:    "CensusACS5YearSurvey",
+						IsDcAggregate: true,
+					},
+				},
+			},
+			"",
+			&pb.PointStat{
+				Date:  "2019",
+				Value: 300,
+			},
+			&pb.StatMetadata{
+				ImportName:    "CensusACS5YearSurvey",
+				IsDcAggregate: true,
+			},
+		},
 	} {
-		ps, meta := getValueFromBestSourcePb(c.obs, c.date)
+		ps, meta := getValueFromBestSourcePb(c.obs, c.date, ranking.Options{})
 		if diff := cmp.Diff(ps, c.ps, protocmp.Transform()); diff != "" {
 			t.Errorf("getValueFromBestSourcePb() got diff PointStat %v", diff)
 		}
@@ -120,3 +144,103 @@ func TestGetValueFromBestSourcePb(t *testing.T) {
 		}
 	}
 }
+
+func TestGetStitchedSeries(t *testing.T) {
+	obs := &pb.ObsTimeSeries{
+		SourceSeries: []*pb.SourceSeries{
+			{
+				Val:               map[string]float64{"2019": 110, "2020": 120},
+				ImportName:        "CensusACS5YearSurvey",
+				MeasurementMethod: "CensusACS5yrSurvey",
+			},
+			{
+				Val:               map[string]float64{"2018": 200, "2019": 210},
+				ImportName:        "CensusPEP",
+				MeasurementMethod: "CensusPEPSurvey",
+			},
+			{
+				Val:        map[string]float64{"2017": 0.3},
+				ImportName: "OtherImport",
+				Unit:       "Percent",
+			},
+			{
+				Val:               map[string]float64{"2016-01": 15},
+				ImportName:        "OtherImport",
+				MeasurementMethod: "CensusPEPSurvey",
+				ObservationPeriod: "P1M",
+			},
+		},
+	}
+	pepMeta := &pb.StatMetadata{
+		ImportName:        "CensusPEP",
+		MeasurementMethod: "CensusPEPSurvey",
+	}
+	acsMeta := &pb.StatMetadata{
+		ImportName:        "CensusACS5YearSurvey",
+		MeasurementMethod: "CensusACS5yrSurvey",
+	}
+	pepHash := getMetadataHash(pepMeta)
+	acsHash := getMetadataHash(acsMeta)
+	// CensusPEP is ranked first, the percent and monthly series are not
+	// stitched.
+	for _, c := range []struct {
+		mixMethods   bool
+		want         *pb.Series
+		wantMetadata map[uint32]*pb.StatMetadata
+	}{
+		{
+			false,
+			&pb.Series{
+				Val:      map[string]float64{"2018": 200, "2019": 210},
+				Metadata: pepMeta,
+				MetaHashes: map[string]uint32{
+					"2018": pepHash,
+					"2019": pepHash,
+				},
+			},
+			map[uint32]*pb.StatMetadata{pepHash: pepMeta},
+		},
+		{
+			true,
+			&pb.Series{
+				Val:      map[string]float64{"2018": 200, "2019": 210, "2020": 120},
+				Metadata: pepMeta,
+				MetaHashes: map[string]uint32{
+					"2018": pepHash,
+					"2019": pepHash,
+					"2020": acsHash,
+				},
+			},
+			map[uint32]*pb.StatMetadata{pepHash: pepMeta, acsHash: acsMeta},
+		},
+	} {
+		series, metadata := getStitchedSeries(obs, ranking.Options{}, c.mixMethods)
+		if diff := cmp.Diff(series, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("getStitchedSeries(%v) got diff: %v", c.mixMethods, diff)
+		}
+		if diff := cmp.Diff(metadata, c.wantMetadata, protocmp.Transform()); diff != "" {
+			t.Errorf("getStitchedSeries(%v) got diff metadata: %v", c.mixMethods, diff)
+		}
+	}
+}
+
+func TestRawSeriesToSeries(t *testing.T) {
+	source := &pb.SourceSeries{
+		Val:           map[string]float64{"2020": 10},
+		ImportName:    "CensusPEP",
+		Unit:          "Person",
+		IsDcAggregate: true,
+	}
+	got := rawSeriesToSeries(source)
+	want := &pb.Series{
+		Val: map[string]float64{"2020": 10},
+		Metadata: &pb.StatMetadata{
+			ImportName:    "CensusPEP",
+			Unit:          "Person",
+			IsDcAggregate: true,
+		},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("rawSeriesToSeries() got diff: %v", diff)
+	}
+}
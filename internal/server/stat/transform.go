@@ -0,0 +1,212 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/model"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Derived series transforms.
+const (
+	transformDiff   = "diff"
+	transformYoyPct = "yoy_pct"
+	transformCagr   = "cagr"
+)
+
+// parseTransform validates the transform of a request. Empty means none.
+func parseTransform(transform string) (string, error) {
+	transform = strings.ToLower(transform)
+	switch transform {
+	case "", transformDiff, transformYoyPct, transformCagr:
+		return transform, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument,
+		"Invalid transform %s, should be one of diff, yoy_pct and cagr", transform)
+}
+
+// yearsBetween returns the elapsed years from the start of one date to the
+// start of another. Whole calendar years are counted first, so "2010" to
+// "2015" is exactly 5 years, and the rest is a fraction of the next year.
+func yearsBetween(from, to string) (float64, bool) {
+	t0, ok := parseDate(from)
+	if !ok {
+		return 0, false
+	}
+	t1, ok := parseDate(to)
+	if !ok || t1.Before(t0) {
+		return 0, false
+	}
+	years := 0
+	for !t0.AddDate(years+1, 0, 0).After(t1) {
+		years++
+	}
+	start := t0.AddDate(years, 0, 0)
+	next := t0.AddDate(years+1, 0, 0)
+	return float64(years) + float64(t1.Sub(start))/float64(next.Sub(start)), true
+}
+
+// changeBetween computes the transform from the base value to the value.
+func changeBetween(
+	transform, baseDate string, base float64, date string, value float64) (float64, bool) {
+	switch transform {
+	case transformDiff:
+		return value - base, true
+	case transformYoyPct:
+		if base == 0 {
+			return 0, false
+		}
+		return (value/base - 1) * 100, true
+	case transformCagr:
+		years, ok := yearsBetween(baseDate, date)
+		if !ok || years <= 0 || base <= 0 || value < 0 {
+			return 0, false
+		}
+		return (math.Pow(value/base, 1/years) - 1) * 100, true
+	}
+	return 0, false
+}
+
+// transformVal computes the derived series. Observations are only compared
+// with the ones of the same date granularity. Dates without a base observation
+// are dropped.
+func transformVal(val map[string]float64, transform string, periods int) map[string]float64 {
+	if periods <= 0 {
+		periods = 1
+	}
+	byLength := map[int][]string{}
+	for date := range val {
+		byLength[len(date)] = append(byLength[len(date)], date)
+	}
+	result := map[string]float64{}
+	for length, dates := range byLength {
+		sort.Strings(dates)
+		for i, date := range dates {
+			baseDate := ""
+			if transform == transformYoyPct {
+				step, ok := dateSteps[length]
+				if !ok {
+					continue
+				}
+				t, ok := parseDate(date)
+				if !ok {
+					continue
+				}
+				baseDate = t.AddDate(-periods, 0, 0).Format(step.layout)
+				if _, ok := val[baseDate]; !ok {
+					continue
+				}
+			} else {
+				if i < periods {
+					continue
+				}
+				baseDate = dates[i-periods]
+			}
+			if v, ok := changeBetween(
+				transform, baseDate, val[baseDate], date, val[date]); ok {
+				result[date] = v
+			}
+		}
+	}
+	return result
+}
+
+// transformSeries computes the derived series. The input is not modified.
+// Returns nil if there is no value left.
+func transformSeries(series *pb.Series, transform string, periods int) *pb.Series {
+	if series == nil || transform == "" {
+		return series
+	}
+	val := transformVal(series.Val, transform, periods)
+	if len(val) == 0 {
+		return nil
+	}
+	return &pb.Series{
+		Val:                 val,
+		Metadata:            series.Metadata,
+		DenominatorMetadata: series.DenominatorMetadata,
+		FilledDates:         keepFilledDates(series.FilledDates, val),
+		MetaHashes:          keepMetaHashes(series.MetaHashes, val),
+	}
+}
+
+// getChangeFromBestSource gets the change between two dates from the top
+// ranked source series that has both dates. When date is not given, the latest
+// date of each source is used.
+func getChangeFromBestSource(
+	in *model.ObsTimeSeries, date, baseDate, transform string, opts ranking.Options,
+) (float64, error) {
+	if in == nil {
+		return 0, status.Error(codes.Internal, "Nil obs time series for getChangeFromBestSource()")
+	}
+	sourceSeries := in.SourceSeries
+	sourceSeries = ranking.Sort(sourceSeries, opts)
+	for _, series := range sourceSeries {
+		if v, ok := changeInSeries(series.Val, date, baseDate, transform); ok {
+			return v.Value, nil
+		}
+	}
+	return 0, status.Errorf(codes.NotFound,
+		"No data found for date %s and base date %s", date, baseDate)
+}
+
+// getChangeFromBestSourcePb is the protobuf version of getChangeFromBestSource.
+func getChangeFromBestSourcePb(
+	in *pb.ObsTimeSeries, date, baseDate, transform string, opts ranking.Options,
+) (*pb.PointStat, *pb.StatMetadata) {
+	if in == nil {
+		return nil, nil
+	}
+	sourceSeries := in.SourceSeries
+	sourceSeries = ranking.SortSeries(sourceSeries, opts)
+	for _, series := range sourceSeries {
+		if ps, ok := changeInSeries(series.Val, date, baseDate, transform); ok {
+			return ps, sourceSeriesMetadata(series)
+		}
+	}
+	return nil, nil
+}
+
+// changeInSeries computes the change between two dates within one series.
+func changeInSeries(
+	val map[string]float64, date, baseDate, transform string) (*pb.PointStat, bool) {
+	base, ok := val[baseDate]
+	if !ok {
+		return nil, false
+	}
+	if date == "" {
+		for d := range val {
+			if d > date {
+				date = d
+			}
+		}
+	}
+	value, ok := val[date]
+	if !ok {
+		return nil, false
+	}
+	change, ok := changeBetween(transform, baseDate, base, date, value)
+	if !ok {
+		return nil, false
+	}
+	return &pb.PointStat{Date: date, Value: change, BaseDate: baseDate}, true
+}
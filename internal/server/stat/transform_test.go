@@ -0,0 +1,99 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestTransformVal(t *testing.T) {
+	approx := cmpopts.EquateApprox(0, 1e-9)
+	for _, c := range []struct {
+		val       map[string]float64
+		transform string
+		periods   int
+		want      map[string]float64
+	}{
+		{
+			map[string]float64{"2010": 100, "2015": 150, "2016": 165},
+			transformDiff,
+			1,
+			map[string]float64{"2015": 50, "2016": 15},
+		},
+		{
+			map[string]float64{"2010": 100, "2015": 150, "2016": 165},
+			transformDiff,
+			2,
+			map[string]float64{"2016": 65},
+		},
+		{
+			// Uneven spacing: 2010 to 2015 is annualized over 5 years.
+			map[string]float64{"2010": 100, "2015": 100 * math.Pow(1.1, 5), "2016": 100 * math.Pow(1.1, 6)},
+			transformCagr,
+			1,
+			map[string]float64{"2015": 10, "2016": 10},
+		},
+		{
+			// Monthly data compares with the same month of the previous year.
+			map[string]float64{"2019-03": 200, "2020-02": 90, "2020-03": 250},
+			transformYoyPct,
+			1,
+			map[string]float64{"2020-03": 25},
+		},
+	} {
+		got := transformVal(c.val, c.transform, c.periods)
+		if diff := cmp.Diff(got, c.want, approx); diff != "" {
+			t.Errorf("transformVal(%s, %d) got diff: %v", c.transform, c.periods, diff)
+		}
+	}
+	if _, err := parseTransform("log"); err == nil {
+		t.Errorf("parseTransform(log) should return error")
+	}
+}
+
+func TestGetChangeFromBestSourcePb(t *testing.T) {
+	obs := &pb.ObsTimeSeries{
+		SourceSeries: []*pb.SourceSeries{
+			{
+				Val:               map[string]float64{"2019": 300},
+				ImportName:        "CensusPEP",
+				MeasurementMethod: "CensusPEPSurvey",
+			},
+			{
+				Val:               map[string]float64{"2015": 100, "2019": 120},
+				ImportName:        "CensusACS5YearSurvey",
+				MeasurementMethod: "CensusACS5yrSurvey",
+			},
+		},
+	}
+	// CensusPEP is preferred but has no base date.
+	ps, meta := getChangeFromBestSourcePb(obs, "", "2015", transformYoyPct, ranking.Options{})
+	want := &pb.PointStat{Date: "2019", Value: 20, BaseDate: "2015"}
+	if diff := cmp.Diff(ps, want, protocmp.Transform(),
+		cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+		t.Errorf("getChangeFromBestSourcePb() got diff: %v", diff)
+	}
+	if meta.GetImportName() != "CensusACS5YearSurvey" {
+		t.Errorf("getChangeFromBestSourcePb() got import %s, want CensusACS5YearSurvey",
+			meta.GetImportName())
+	}
+}
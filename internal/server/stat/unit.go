@@ -0,0 +1,101 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/convert"
+	"github.com/datacommonsorg/mixer/internal/server/model"
+	"google.golang.org/protobuf/proto"
+)
+
+// convertVal converts the values to the target unit. Returns false when the
+// unit can not be converted.
+func convertVal(
+	val map[string]float64, unit, scalingFactor, targetUnit string,
+) (map[string]float64, bool) {
+	result := make(map[string]float64, len(val))
+	for date, value := range val {
+		v, ok := convert.ConvertUnit(value, unit, scalingFactor, targetUnit)
+		if !ok {
+			return nil, false
+		}
+		result[date] = v
+	}
+	return result, true
+}
+
+// convertSourceSeries converts the source series to the target unit and drops
+// the ones that can not be converted, so they are not ranked against the
+// converted ones.
+func convertSourceSeries(in []*model.SourceSeries, targetUnit string) []*model.SourceSeries {
+	if targetUnit == "" {
+		return in
+	}
+	result := []*model.SourceSeries{}
+	for _, series := range in {
+		val, ok := convertVal(series.Val, series.Unit, series.ScalingFactor, targetUnit)
+		if !ok {
+			continue
+		}
+		converted := *series
+		converted.Val = val
+		converted.Unit = targetUnit
+		converted.ScalingFactor = ""
+		result = append(result, &converted)
+	}
+	return result
+}
+
+// convertSourceSeriesPb is convertSourceSeries for *pb.SourceSeries.
+func convertSourceSeriesPb(in []*pb.SourceSeries, targetUnit string) []*pb.SourceSeries {
+	if targetUnit == "" {
+		return in
+	}
+	result := []*pb.SourceSeries{}
+	for _, series := range in {
+		val, ok := convertVal(series.Val, series.Unit, series.ScalingFactor, targetUnit)
+		if !ok {
+			continue
+		}
+		converted := proto.Clone(series).(*pb.SourceSeries)
+		converted.Val = val
+		converted.Unit = targetUnit
+		converted.ScalingFactor = ""
+		result = append(result, converted)
+	}
+	return result
+}
+
+// convertSeries converts a series to the target unit. Returns nil when the
+// unit can not be converted.
+func convertSeries(series *pb.Series, targetUnit string) *pb.Series {
+	if targetUnit == "" || series == nil {
+		return series
+	}
+	meta := series.GetMetadata()
+	val, ok := convertVal(series.Val, meta.GetUnit(), meta.GetScalingFactor(), targetUnit)
+	if !ok {
+		return nil
+	}
+	result := proto.Clone(series).(*pb.Series)
+	result.Val = val
+	if result.Metadata == nil {
+		result.Metadata = &pb.StatMetadata{}
+	}
+	result.Metadata.Unit = targetUnit
+	result.Metadata.ScalingFactor = ""
+	return result
+}
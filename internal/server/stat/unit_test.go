@@ -0,0 +1,63 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stat
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestConvertSourceSeriesPb(t *testing.T) {
+	in := []*pb.SourceSeries{
+		{
+			ImportName: "BEA",
+			Unit:       "ThousandUSDollar",
+			Val:        map[string]float64{"2019": 1.5, "2020": 2},
+		},
+		{
+			ImportName: "Eurostat",
+			Unit:       "Euro",
+			Val:        map[string]float64{"2020": 1800},
+		},
+		{
+			ImportName:    "Census",
+			Unit:          "USDollar",
+			ScalingFactor: "0.001",
+			Val:           map[string]float64{"2020": 3},
+		},
+	}
+	want := []*pb.SourceSeries{
+		{
+			ImportName: "BEA",
+			Unit:       "USDollar",
+			Val:        map[string]float64{"2019": 1500, "2020": 2000},
+		},
+		{
+			ImportName: "Census",
+			Unit:       "USDollar",
+			Val:        map[string]float64{"2020": 3000},
+		},
+	}
+	got := convertSourceSeriesPb(in, "USDollar")
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("convertSourceSeriesPb() got diff: %v", diff)
+	}
+	if in[0].Unit != "ThousandUSDollar" {
+		t.Errorf("convertSourceSeriesPb() should not modify the input")
+	}
+}
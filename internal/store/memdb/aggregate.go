@@ -0,0 +1,131 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// AggregationSum is the manifest aggregation method that sums the values of
+// child places.
+const AggregationSum = "sum"
+
+// GetSummableStatVars returns the stat vars that are declared with sum
+// aggregation in the manifest and have data.
+func (memDb *MemDb) GetSummableStatVars() []string {
+	memDb.lock.RLock()
+	defer memDb.lock.RUnlock()
+	result := []string{}
+	for statVar, method := range memDb.manifest.GetAggregation() {
+		if method != AggregationSum {
+			continue
+		}
+		if svID, ok := memDb.statVars.lookup(statVar); ok {
+			if _, ok := memDb.columns[svID]; ok {
+				result = append(result, statVar)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// GetPlaces returns the places that have imported data for any of the stat
+// vars.
+func (memDb *MemDb) GetPlaces(statVars []string) []string {
+	memDb.lock.RLock()
+	defer memDb.lock.RUnlock()
+	placeIDs := map[int32]struct{}{}
+	for _, statVar := range statVars {
+		svID, ok := memDb.statVars.lookup(statVar)
+		if !ok {
+			continue
+		}
+		for placeID := range memDb.columns[svID] {
+			placeIDs[placeID] = struct{}{}
+		}
+	}
+	result := []string{}
+	for placeID := range placeIDs {
+		result = append(result, memDb.places.get(placeID))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// AddSum sums the series of the child places and adds the result to the
+// parent place, with metadata marked as Data Commons aggregate.
+//
+// An observation is only added when every child place has a value for the
+// date with the same metadata, so a partial cohort never yields an
+// understated total. A parent that already has data for the stat var is left
+// untouched. Returns the number of observations added.
+func (memDb *MemDb) AddSum(statVar, parent string, children []string) int {
+	memDb.lock.Lock()
+	defer memDb.lock.Unlock()
+	if len(children) == 0 || len(memDb.getColumns(statVar, parent)) > 0 {
+		return 0
+	}
+	// Imported columns of each child, keyed by metadata id.
+	childColumns := make([]map[int32]*column, len(children))
+	for i, child := range children {
+		childColumns[i] = map[int32]*column{}
+		for _, c := range memDb.getColumns(statVar, child) {
+			if !memDb.metas[c.meta].GetIsDcAggregate() {
+				childColumns[i][c.meta] = c
+			}
+		}
+		if len(childColumns[i]) == 0 {
+			return 0
+		}
+	}
+	count := 0
+	for metaID, first := range childColumns[0] {
+		columns := []*column{first}
+		for _, cols := range childColumns[1:] {
+			if c, ok := cols[metaID]; ok {
+				columns = append(columns, c)
+			}
+		}
+		if len(columns) != len(children) {
+			continue
+		}
+		meta := proto.Clone(memDb.metas[metaID]).(*pb.StatMetadata)
+		meta.IsDcAggregate = true
+		for i, dateID := range first.dates {
+			date := memDb.dates.get(dateID)
+			sum := first.values[i]
+			complete := true
+			for _, c := range columns[1:] {
+				v, ok := c.get(memDb.dates, date)
+				if !ok {
+					complete = false
+					break
+				}
+				sum += v
+			}
+			if !complete {
+				continue
+			}
+			svID, placeID := memDb.touch(statVar, parent)
+			memDb.addObs(svID, placeID, meta, date, sum)
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestAddSum(t *testing.T) {
+	header := []string{
+		"Date",
+		"GeoId",
+		"CumulativeCount_Vaccine_COVID_19_Administered",
+		"IncrementalCount_Vaccine_COVID_19_Administered",
+	}
+	rows := [][]string{
+		{"2020-03-22", "geoId/06001", "100", "10"},
+		{"2020-03-23", "geoId/06001", "150", "50"},
+		{"2020-03-22", "geoId/06003", "30", "3"},
+		{"2020-03-22", "geoId/06", "1000", ""},
+	}
+	memDb := NewMemDb()
+	memDb.manifest = &pb.Manifest{
+		ImportName:    "Private Import",
+		ProvenanceUrl: "private.domain",
+		Aggregation: map[string]string{
+			"CumulativeCount_Vaccine_COVID_19_Administered":  AggregationSum,
+			"IncrementalCount_Vaccine_COVID_19_Administered": AggregationSum,
+			"Count_Unknown": AggregationSum,
+		},
+	}
+	for _, row := range rows {
+		if err := memDb.addRow(header, row, ts); err != nil {
+			t.Fatalf("addRow() got error %v", err)
+		}
+	}
+
+	wantStatVars := []string{
+		"CumulativeCount_Vaccine_COVID_19_Administered",
+		"IncrementalCount_Vaccine_COVID_19_Administered",
+	}
+	if diff := cmp.Diff(memDb.GetSummableStatVars(), wantStatVars); diff != "" {
+		t.Errorf("GetSummableStatVars() got diff: %v", diff)
+	}
+	wantPlaces := []string{"geoId/06", "geoId/06001", "geoId/06003"}
+	if diff := cmp.Diff(memDb.GetPlaces(wantStatVars), wantPlaces); diff != "" {
+		t.Errorf("GetPlaces() got diff: %v", diff)
+	}
+
+	children := []string{"geoId/06001", "geoId/06003"}
+	for _, c := range []struct {
+		statVar  string
+		parent   string
+		children []string
+		want     int
+	}{
+		// Only 2020-03-22 has values for all the children.
+		{"IncrementalCount_Vaccine_COVID_19_Administered", "country/USA", children, 1},
+		// Parent has imported data.
+		{"CumulativeCount_Vaccine_COVID_19_Administered", "geoId/06", children, 0},
+		// A child has no data.
+		{"CumulativeCount_Vaccine_COVID_19_Administered", "country/USA",
+			append(children, "geoId/06005"), 0},
+	} {
+		if got := memDb.AddSum(c.statVar, c.parent, c.children); got != c.want {
+			t.Errorf("AddSum(%s, %s) = %d, want %d", c.statVar, c.parent, got, c.want)
+		}
+	}
+	want := []*pb.Series{
+		{
+			Val: map[string]float64{"2020-03-22": 13},
+			Metadata: &pb.StatMetadata{
+				MeasurementMethod: "OurWorldInData_COVID19",
+				ImportName:        "Private Import",
+				ProvenanceUrl:     "private.domain",
+				IsDcAggregate:     true,
+			},
+		},
+	}
+	got := memDb.ReadSeries("IncrementalCount_Vaccine_COVID_19_Administered", "country/USA")
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("ReadSeries() got diff: %v", diff)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"sort"
+)
+
+// stringTable interns strings so each distinct place, stat var and date is
+// stored once and referenced by a small integer id.
+type stringTable struct {
+	ids    map[string]int32
+	values []string
+}
+
+func newStringTable() *stringTable {
+	return &stringTable{ids: map[string]int32{}}
+}
+
+// intern returns the id of a string, adding it to the table if needed.
+func (t *stringTable) intern(s string) int32 {
+	if id, ok := t.ids[s]; ok {
+		return id
+	}
+	id := int32(len(t.values))
+	t.ids[s] = id
+	t.values = append(t.values, s)
+	return id
+}
+
+// lookup returns the id of a string without adding it.
+func (t *stringTable) lookup(s string) (int32, bool) {
+	id, ok := t.ids[s]
+	return id, ok
+}
+
+func (t *stringTable) get(id int32) string {
+	return t.values[id]
+}
+
+func (t *stringTable) len() int {
+	return len(t.values)
+}
+
+// approxBytes estimates the heap used by the table: the string bytes, the
+// string headers in the slice and the map entries.
+func (t *stringTable) approxBytes() int64 {
+	var size int64
+	for _, s := range t.values {
+		size += int64(len(s)) + 16 + 48
+	}
+	return size
+}
+
+// column holds the observations of one series in columnar layout.
+//
+// dates and values are parallel slices ordered by the date string, so the
+// latest observation is the last element and a date lookup is a binary search.
+type column struct {
+	// Index into MemDb.metas.
+	meta   int32
+	dates  []int32
+	values []float64
+}
+
+// search returns the position of the first date that is not before the given
+// date.
+func (c *column) search(dates *stringTable, date string) int {
+	return sort.Search(len(c.dates), func(i int) bool {
+		return dates.get(c.dates[i]) >= date
+	})
+}
+
+// set adds an observation, replacing the value if the date already exists.
+func (c *column) set(dates *stringTable, dateID int32, value float64) {
+	date := dates.get(dateID)
+	n := len(c.dates)
+	// Rows usually come in date order, so check the tail first.
+	if n == 0 || dates.get(c.dates[n-1]) < date {
+		c.dates = append(c.dates, dateID)
+		c.values = append(c.values, value)
+		return
+	}
+	i := c.search(dates, date)
+	if i < n && c.dates[i] == dateID {
+		c.values[i] = value
+		return
+	}
+	c.dates = append(c.dates, 0)
+	c.values = append(c.values, 0)
+	copy(c.dates[i+1:], c.dates[i:])
+	copy(c.values[i+1:], c.values[i:])
+	c.dates[i] = dateID
+	c.values[i] = value
+}
+
+// get returns the value at a date.
+func (c *column) get(dates *stringTable, date string) (float64, bool) {
+	i := c.search(dates, date)
+	if i < len(c.dates) && dates.get(c.dates[i]) == date {
+		return c.values[i], true
+	}
+	return 0, false
+}
+
+// latest returns the last date and its value.
+func (c *column) latest(dates *stringTable) (string, float64, bool) {
+	n := len(c.dates)
+	if n == 0 {
+		return "", 0, false
+	}
+	return dates.get(c.dates[n-1]), c.values[n-1], true
+}
+
+// between returns the positions [left, right) of dates within the inclusive
+// range [startDate, endDate]. Empty bound means unbounded.
+//
+// An end date of coarser granularity includes its sub-periods, for example end
+// date "2020" includes "2020-05".
+func (c *column) between(dates *stringTable, startDate, endDate string) (int, int) {
+	left := 0
+	if startDate != "" {
+		left = c.search(dates, startDate)
+	}
+	right := len(c.dates)
+	if endDate != "" {
+		right = sort.Search(len(c.dates), func(i int) bool {
+			d := dates.get(c.dates[i])
+			return d > endDate && !isPrefixDate(endDate, d)
+		})
+	}
+	if right < left {
+		right = left
+	}
+	return left, right
+}
+
+// isPrefixDate checks if date is within the period of a coarser ISO date,
+// like "2020-05" within "2020".
+func isPrefixDate(period, date string) bool {
+	return len(date) > len(period) &&
+		date[:len(period)] == period &&
+		date[len(period)] == '-'
+}
+
+func (c *column) approxBytes() int64 {
+	// 4 bytes per date id, 8 bytes per value and the struct overhead.
+	return int64(cap(c.dates))*4 + int64(cap(c.values))*8 + 64
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/datacommonsorg/mixer/internal/parser/tmcf"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// parseJSONLD parses StatVarObservation nodes from a JSON-LD document.
+//
+// The document can be a node, a list of nodes or an object with "@graph".
+// Terms are matched by local name after removing the schema namespace, so
+// "value", "dcs:value" and "https://datacommons.org/value" are the same. The
+// @context is not expanded otherwise.
+func parseJSONLD(data []byte, manifest *pb.Manifest) ([]*nodeObs, error) {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid json-ld: %v", err)
+	}
+	var nodes []interface{}
+	switch d := doc.(type) {
+	case []interface{}:
+		nodes = d
+	case map[string]interface{}:
+		if graph, ok := d["@graph"].([]interface{}); ok {
+			nodes = graph
+		} else {
+			nodes = []interface{}{d}
+		}
+	}
+	result := []*nodeObs{}
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		props := map[string]string{}
+		isObs := false
+		for key, value := range node {
+			prop := tmcf.TrimNamespace(key)
+			if prop == "@type" || prop == "typeOf" {
+				for _, typ := range jsonLDValues(value) {
+					if typ == "StatVarObservation" {
+						isObs = true
+					}
+				}
+				continue
+			}
+			if values := jsonLDValues(value); len(values) > 0 {
+				props[prop] = values[0]
+			}
+		}
+		if !isObs {
+			continue
+		}
+		result = append(result, &nodeObs{
+			statVar: props["variableMeasured"],
+			place:   props["observationAbout"],
+			date:    props["observationDate"],
+			value:   props["value"],
+			meta: &pb.StatMetadata{
+				ProvenanceUrl:     manifest.GetProvenanceUrl(),
+				ImportName:        manifest.GetImportName(),
+				MeasurementMethod: props["measurementMethod"],
+				Unit:              props["unit"],
+				ScalingFactor:     props["scalingFactor"],
+				ObservationPeriod: props["observationPeriod"],
+			},
+		})
+	}
+	return result, nil
+}
+
+// jsonLDValues converts a JSON-LD property value to strings. It handles
+// literals, value objects like {"@value": 1}, node references like
+// {"@id": "dcid:geoId/06"} and lists of them.
+func jsonLDValues(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{tmcf.TrimNamespace(v)}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	case map[string]interface{}:
+		if id, ok := v["@id"]; ok {
+			return jsonLDValues(id)
+		}
+		if val, ok := v["@value"]; ok {
+			return jsonLDValues(val)
+		}
+	case []interface{}:
+		result := []string{}
+		for _, item := range v {
+			result = append(result, jsonLDValues(item)...)
+		}
+		return result
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseJSONLD(t *testing.T) {
+	data := `{
+  "@context": {"dcs": "https://datacommons.org/"},
+  "@graph": [
+    {
+      "@type": "dcs:StatVarObservation",
+      "dcs:variableMeasured": {"@id": "dcs:Count_Person"},
+      "dcs:observationAbout": {"@id": "dcid:geoId/06"},
+      "dcs:observationDate": "2020",
+      "dcs:value": 39512223,
+      "dcs:measurementMethod": "CensusACS5yrSurvey"
+    },
+    {
+      "@type": ["StatVarObservation"],
+      "variableMeasured": "Count_Person",
+      "observationAbout": "geoId/06",
+      "observationDate": "2021",
+      "value": {"@value": "39.5", "@type": "xsd:double"},
+      "scalingFactor": "1000000"
+    },
+    {
+      "@type": "Place",
+      "name": "California"
+    }
+  ]
+}`
+	memDb := NewMemDb()
+	memDb.manifest = manifest
+	nodes, err := parseJSONLD([]byte(data), memDb.manifest)
+	if err != nil {
+		t.Fatalf("parseJSONLD() got error %v", err)
+	}
+	for _, obs := range nodes {
+		if err := memDb.addNodeObs(obs); err != nil {
+			t.Fatalf("addNodeObs() got error %v", err)
+		}
+	}
+	want := map[string]map[string][]*pb.Series{
+		"Count_Person": {
+			"geoId/06": {
+				{
+					Val: map[string]float64{"2020": 39512223},
+					Metadata: &pb.StatMetadata{
+						MeasurementMethod: "CensusACS5yrSurvey",
+						ImportName:        "Private Import",
+						ProvenanceUrl:     "private.domain",
+					},
+				},
+				{
+					Val: map[string]float64{"2021": 39.5},
+					Metadata: &pb.StatMetadata{
+						ScalingFactor: "1000000",
+						ImportName:    "Private Import",
+						ProvenanceUrl: "private.domain",
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(dump(memDb), want, protocmp.Transform()); diff != "" {
+		t.Errorf("parseJSONLD() got diff: %v", diff)
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	"cloud.google.com/go/pubsub"
 	"cloud.google.com/go/storage"
+	"github.com/datacommonsorg/mixer/internal/parser/csvw"
 	"github.com/datacommonsorg/mixer/internal/parser/tmcf"
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	dcpubsub "github.com/datacommonsorg/mixer/internal/pubsub"
@@ -38,19 +39,52 @@ import (
 )
 
 // MemDb holds imported data in memory.
+//
+// Observations are stored in columnar layout: places, stat vars, dates and
+// metadata are interned, and each series is a pair of date id and value slices
+// sorted by date.
 type MemDb struct {
-	// statVar -> place -> []Series
-	statSeries map[string]map[string][]*pb.Series
-	manifest   *pb.Manifest
-	lock       sync.RWMutex
+	places   *stringTable
+	statVars *stringTable
+	dates    *stringTable
+	metas    []*pb.StatMetadata
+	// Keyed by the metadata text, value is the index in metas.
+	metaIds map[string]int32
+	// statVar id -> place id -> []column
+	columns  map[int32]map[int32][]*column
+	manifest *pb.Manifest
+	lock     sync.RWMutex
+	// afterLoad is run after each load, without holding the lock.
+	afterLoad func(ctx context.Context) error
+}
+
+// Stats holds the size information of a MemDb, used for capacity planning.
+type Stats struct {
+	NumStatVars     int
+	NumPlaces       int
+	NumDates        int
+	NumSeries       int
+	NumObservations int
+	// ApproxBytes is an estimate of the heap used by the stored data.
+	ApproxBytes int64
 }
 
 // NewMemDb initialize a MemDb instance.
 func NewMemDb() *MemDb {
-	return &MemDb{
-		statSeries: map[string]map[string][]*pb.Series{},
-		manifest:   &pb.Manifest{},
-	}
+	memDb := &MemDb{}
+	memDb.reset()
+	return memDb
+}
+
+// reset clears all the data.
+func (memDb *MemDb) reset() {
+	memDb.places = newStringTable()
+	memDb.statVars = newStringTable()
+	memDb.dates = newStringTable()
+	memDb.metas = nil
+	memDb.metaIds = map[string]int32{}
+	memDb.columns = map[int32]map[int32][]*column{}
+	memDb.manifest = &pb.Manifest{}
 }
 
 // GetManifest get the manifest data.
@@ -64,19 +98,91 @@ func (memDb *MemDb) GetManifest() *pb.Manifest {
 func (memDb *MemDb) IsEmpty() bool {
 	memDb.lock.RLock()
 	defer memDb.lock.RUnlock()
-	return memDb.statSeries == nil || len(memDb.statSeries) == 0
+	return len(memDb.columns) == 0
+}
+
+// Stats computes the size information of the memory database.
+func (memDb *MemDb) Stats() *Stats {
+	memDb.lock.RLock()
+	defer memDb.lock.RUnlock()
+	return memDb.stats()
+}
+
+func (memDb *MemDb) stats() *Stats {
+	result := &Stats{
+		NumStatVars: memDb.statVars.len(),
+		NumPlaces:   memDb.places.len(),
+		NumDates:    memDb.dates.len(),
+		ApproxBytes: memDb.statVars.approxBytes() +
+			memDb.places.approxBytes() +
+			memDb.dates.approxBytes(),
+	}
+	for _, placeData := range memDb.columns {
+		// Map entry of the stat var.
+		result.ApproxBytes += 48
+		for _, columns := range placeData {
+			// Map entry and slice header of the place.
+			result.ApproxBytes += 48
+			for _, c := range columns {
+				result.NumSeries++
+				result.NumObservations += len(c.dates)
+				result.ApproxBytes += c.approxBytes()
+			}
+		}
+	}
+	return result
+}
+
+// getColumns gets the columns of a <stat var, place>. Caller should hold the
+// lock.
+func (memDb *MemDb) getColumns(statVar, place string) []*column {
+	svID, ok := memDb.statVars.lookup(statVar)
+	if !ok {
+		return nil
+	}
+	placeID, ok := memDb.places.lookup(place)
+	if !ok {
+		return nil
+	}
+	return memDb.columns[svID][placeID]
+}
+
+// toSeries converts the observations [left, right) of a column to pb.Series.
+func (memDb *MemDb) toSeries(c *column, left, right int) *pb.Series {
+	series := &pb.Series{
+		Val:      make(map[string]float64, right-left),
+		Metadata: memDb.metas[c.meta],
+	}
+	for i := left; i < right; i++ {
+		series.Val[memDb.dates.get(c.dates[i])] = c.values[i]
+	}
+	return series
 }
 
 // ReadSeries reads stat series from in-memory DB.
 func (memDb *MemDb) ReadSeries(statVar, place string) []*pb.Series {
 	memDb.lock.RLock()
 	defer memDb.lock.RUnlock()
-	if _, ok := memDb.statSeries[statVar]; ok {
-		if series, ok := memDb.statSeries[statVar][place]; ok {
-			return series
+	result := []*pb.Series{}
+	for _, c := range memDb.getColumns(statVar, place) {
+		result = append(result, memDb.toSeries(c, 0, len(c.dates)))
+	}
+	return result
+}
+
+// ReadSeriesInRange reads stat series with dates in the inclusive range
+// [startDate, endDate]. An empty bound means unbounded.
+func (memDb *MemDb) ReadSeriesInRange(statVar, place, startDate, endDate string) []*pb.Series {
+	memDb.lock.RLock()
+	defer memDb.lock.RUnlock()
+	result := []*pb.Series{}
+	for _, c := range memDb.getColumns(statVar, place) {
+		left, right := c.between(memDb.dates, startDate, endDate)
+		if left < right {
+			result = append(result, memDb.toSeries(c, left, right))
 		}
 	}
-	return []*pb.Series{}
+	return result
 }
 
 // ReadPointValue reads one observation point.
@@ -87,23 +193,16 @@ func (memDb *MemDb) ReadPointValue(statVar, place, date string) (
 ) {
 	memDb.lock.RLock()
 	defer memDb.lock.RUnlock()
-	placeData, ok := memDb.statSeries[statVar]
-	if !ok {
-		return nil, nil
-	}
-	seriesList, ok := placeData[place]
-	if !ok {
-		return nil, nil
-	}
+	columns := memDb.getColumns(statVar, place)
 	if date != "" {
 		// For private import, pick from a random series. In most cases, there should
 		// be just one series.
-		for _, series := range seriesList {
-			if val, ok := series.Val[date]; ok {
+		for _, c := range columns {
+			if val, ok := c.get(memDb.dates, date); ok {
 				return &pb.PointStat{
 					Date:  date,
 					Value: val,
-				}, series.Metadata
+				}, memDb.metas[c.meta]
 			}
 		}
 	} else {
@@ -111,13 +210,11 @@ func (memDb *MemDb) ReadPointValue(statVar, place, date string) (
 		latestDate := ""
 		var latestVal float64
 		var meta *pb.StatMetadata
-		for _, series := range seriesList {
-			for date, val := range series.Val {
-				if date > latestDate {
-					latestDate = date
-					latestVal = val
-					meta = series.Metadata
-				}
+		for _, c := range columns {
+			if date, val, ok := c.latest(memDb.dates); ok && date > latestDate {
+				latestDate = date
+				latestVal = val
+				meta = memDb.metas[c.meta]
 			}
 		}
 		if latestDate != "" {
@@ -137,18 +234,23 @@ func (memDb *MemDb) GetStatVars(places []string) ([]string, []string) {
 	defer memDb.lock.RUnlock()
 	hasDataStatVars := []string{}
 	noDataStatVars := []string{}
-	for statVar, statVarData := range memDb.statSeries {
+	for svID, statVarData := range memDb.columns {
 		valid := false
 		if len(places) == 0 {
 			valid = true
 		} else {
 			for _, place := range places {
-				if _, ok := statVarData[place]; ok {
+				placeID, ok := memDb.places.lookup(place)
+				if !ok {
+					continue
+				}
+				if _, ok := statVarData[placeID]; ok {
 					valid = true
 					break
 				}
 			}
 		}
+		statVar := memDb.statVars.get(svID)
 		if valid {
 			hasDataStatVars = append(hasDataStatVars, statVar)
 		} else {
@@ -164,24 +266,48 @@ func (memDb *MemDb) GetStatVars(places []string) ([]string, []string) {
 func (memDb *MemDb) HasStatVar(statVar string) bool {
 	memDb.lock.RLock()
 	defer memDb.lock.RUnlock()
-	_, ok := memDb.statSeries[statVar]
+	svID, ok := memDb.statVars.lookup(statVar)
+	if !ok {
+		return false
+	}
+	_, ok = memDb.columns[svID]
 	return ok
 }
 
+// SetAfterLoad sets a function to run after the data is (re)loaded, like
+// aggregating data to ancestor places. It should be set before the first load.
+func (memDb *MemDb) SetAfterLoad(fn func(ctx context.Context) error) {
+	memDb.afterLoad = fn
+}
+
 // LoadFromGcs loads tmcf + csv files into memory database
 func (memDb *MemDb) LoadFromGcs(ctx context.Context, bucket, prefix string) error {
+	if err := memDb.loadFromGcs(ctx, bucket, prefix); err != nil {
+		return err
+	}
+	if memDb.afterLoad != nil {
+		return memDb.afterLoad(ctx)
+	}
+	return nil
+}
+
+func (memDb *MemDb) loadFromGcs(ctx context.Context, bucket, prefix string) error {
 	memDb.lock.Lock()
 	defer memDb.lock.Unlock()
-	memDb.statSeries = map[string]map[string][]*pb.Series{}
-	memDb.manifest = &pb.Manifest{}
+	memDb.reset()
 	gcsClient, err := storage.NewClient(ctx)
 	if err != nil {
 		return err
 	}
-	// The bucket should contain one tmcf and multiple compatible csv files.
+	// The bucket should contain one tmcf and multiple compatible csv or parquet
+	// files. Csv files can also be described by CSVW metadata, and observations
+	// can be given as JSON-LD.
 	bkt := gcsClient.Bucket(bucket)
 	objectQuery := &storage.Query{Prefix: prefix}
 	var objects []string
+	// Generation of the source files, used to check snapshot freshness.
+	generations := map[string]int64{}
+	var snapshotGen int64
 	it := bkt.Objects(ctx, objectQuery)
 	for {
 		attrs, err := it.Next()
@@ -191,7 +317,29 @@ func (memDb *MemDb) LoadFromGcs(ctx context.Context, bucket, prefix string) erro
 		if err != nil {
 			return err
 		}
+		if attrs.Name == snapshotObject(prefix) {
+			snapshotGen = attrs.Generation
+			continue
+		}
 		objects = append(objects, attrs.Name)
+		if isSourceFile(attrs.Name) {
+			generations[attrs.Name] = attrs.Generation
+		}
+	}
+	// Load from the snapshot if it is built from the current source files.
+	if snapshotGen != 0 {
+		snapshot, err := readSnapshot(
+			ctx, bkt.Object(snapshotObject(prefix)).Generation(snapshotGen))
+		if err != nil {
+			log.Printf("Failed to read memdb snapshot: %v", err)
+		} else if isFresh(snapshot, generations) {
+			memDb.fromSnapshot(snapshot)
+			log.Printf("Loaded memdb from snapshot of %d files", len(generations))
+			memDb.logStats()
+			return nil
+		} else {
+			log.Println("Memdb snapshot is stale, reload from csv")
+		}
 	}
 	// Read manifest.json
 	for _, object := range objects {
@@ -215,7 +363,7 @@ func (memDb *MemDb) LoadFromGcs(ctx context.Context, bucket, prefix string) erro
 		}
 	}
 	// Read TMCF
-	var schemaMapping map[string]*tmcf.TableSchema
+	schemaMapping := map[string]*tmcf.TableSchema{}
 	for _, object := range objects {
 		if strings.HasSuffix(object, ".tmcf") {
 			obj := bkt.Object(object)
@@ -235,6 +383,22 @@ func (memDb *MemDb) LoadFromGcs(ctx context.Context, bucket, prefix string) erro
 			break
 		}
 	}
+	// Read CSVW metadata, which describes csv files in place of TMCF.
+	for _, object := range objects {
+		if isCsvwFile(object) {
+			bytes, err := readObject(ctx, bkt.Object(object))
+			if err != nil {
+				return err
+			}
+			mapping, err := csvw.ParseCsvw(string(bytes))
+			if err != nil {
+				return err
+			}
+			for table, schema := range mapping {
+				schemaMapping[table] = schema
+			}
+		}
+	}
 	count := 0
 	for _, object := range objects {
 		if strings.HasSuffix(object, ".csv") {
@@ -267,16 +431,89 @@ func (memDb *MemDb) LoadFromGcs(ctx context.Context, bucket, prefix string) erro
 		}
 	}
 	log.Printf("Number of csv rows added: %d", count)
+	// Read parquet files, which are mapped by the same table schema as csv.
+	count = 0
+	for _, object := range objects {
+		if strings.HasSuffix(object, ".parquet") {
+			bytes, err := readObject(ctx, bkt.Object(object))
+			if err != nil {
+				return err
+			}
+			tableName := strings.TrimSuffix(filepath.Base(object), ".parquet")
+			n, err := memDb.addParquet(bytes, schemaMapping[tableName])
+			if err != nil {
+				return err
+			}
+			count += n
+		}
+	}
+	if count > 0 {
+		log.Printf("Number of parquet rows added: %d", count)
+	}
+	// Read JSON-LD observations.
+	count = 0
+	for _, object := range objects {
+		if strings.HasSuffix(object, ".jsonld") {
+			bytes, err := readObject(ctx, bkt.Object(object))
+			if err != nil {
+				return err
+			}
+			nodes, err := parseJSONLD(bytes, memDb.manifest)
+			if err != nil {
+				return err
+			}
+			for _, obs := range nodes {
+				if err := memDb.addNodeObs(obs); err != nil {
+					return err
+				}
+			}
+			count += len(nodes)
+		}
+	}
+	if count > 0 {
+		log.Printf("Number of json-ld observations added: %d", count)
+	}
+	memDb.logStats()
+	memDb.writeSnapshot(
+		ctx, bkt.Object(snapshotObject(prefix)), snapshotGen, generations)
 	return nil
 }
 
+// isSourceFile checks if a GCS object is loaded into memory database.
+func isSourceFile(object string) bool {
+	return strings.HasSuffix(object, "manifest.json") ||
+		strings.HasSuffix(object, ".tmcf") ||
+		strings.HasSuffix(object, ".csv") ||
+		strings.HasSuffix(object, ".parquet") ||
+		strings.HasSuffix(object, ".jsonld") ||
+		isCsvwFile(object)
+}
+
+// isCsvwFile checks if a GCS object is CSVW metadata, named like
+// "data.csv-metadata.json" or "csv-metadata.json" by the CSVW convention.
+func isCsvwFile(object string) bool {
+	return strings.HasSuffix(object, "csv-metadata.json")
+}
+
+// readObject reads the content of a GCS object.
+func readObject(ctx context.Context, obj *storage.ObjectHandle) ([]byte, error) {
+	r, err := obj.NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
 // nodeObs holds information for one observation
 type nodeObs struct {
 	statVar string
 	place   string
 	date    string // Unpaired date
 	value   string // Unpaired value
-	meta    *pb.StatMetadata
+	// Native numeric value, used instead of parsing value when set.
+	num  *float64
+	meta *pb.StatMetadata
 }
 
 // addRow adds one csv row to memdb
@@ -289,6 +526,32 @@ func (memDb *MemDb) addRow(
 		return status.Errorf(
 			codes.Internal, "No schema mapping found for row: %s", row)
 	}
+	allNodes := memDb.newNodes(schemaMapping)
+	// Process each cell
+	for idx, cell := range row {
+		// Get column name from header
+		colName := header[idx]
+		// Format cell
+		cell = strings.TrimSpace(cell)
+		if cell == "" {
+			continue
+		}
+		if cell[0] == '[' && cell[len(cell)-1] == ']' {
+			cell = tmcf.ParseComplexValue(cell)
+		}
+		setProperties(allNodes, schemaMapping.ColumnInfo[colName], cell, nil)
+	}
+	// Populate observation in the final result.
+	for _, obs := range allNodes {
+		if err := memDb.addNodeObs(obs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newNodes initializes the observation nodes of a row with the fixed schema.
+func (memDb *MemDb) newNodes(schemaMapping *tmcf.TableSchema) map[string]*nodeObs {
 	// Keyed by node id like "E0"
 	allNodes := map[string]*nodeObs{}
 	// Initialize observation entries with the fixed schema
@@ -317,73 +580,109 @@ func (memDb *MemDb) addRow(
 			allNodes[node].meta.ObservationPeriod = v
 		}
 	}
+	return allNodes
+}
 
-	// Process each cell
-	for idx, cell := range row {
-		// Get column name from header
-		colName := header[idx]
-		// Format cell
-		cell = strings.TrimSpace(cell)
-		if cell == "" {
+// setProperties sets the node properties mapped from a cell. num is the native
+// numeric value of the cell if known.
+func setProperties(
+	allNodes map[string]*nodeObs, columns []*tmcf.Column, cell string, num *float64) {
+	// Derive node property and value for observation.
+	for _, col := range columns {
+		n := col.Node
+		if _, ok := allNodes[n]; !ok {
 			continue
 		}
-		if cell[0] == '[' && cell[len(cell)-1] == ']' {
-			cell = tmcf.ParseComplexValue(cell)
-		}
-		// Derive node property and value for observation.
-		for _, col := range schemaMapping.ColumnInfo[colName] {
-			n := col.Node
-			if _, ok := allNodes[n]; !ok {
-				continue
-			}
-			if col.Property == "value" {
-				allNodes[n].value = cell
-			}
-			// TODO: handle the case when observationDate is a constant in the tmcf.
-			if col.Property == "observationDate" {
-				allNodes[n].date = cell
-			}
-			if col.Property == "observationAbout" {
-				allNodes[n].place = cell
-			}
+		if col.Property == "value" {
+			allNodes[n].value = cell
+			allNodes[n].num = num
 		}
-	}
-	// Populate observation in the final result.
-	for _, obs := range allNodes {
-		if _, ok := memDb.statSeries[obs.statVar]; !ok {
-			memDb.statSeries[obs.statVar] = map[string][]*pb.Series{}
+		// TODO: handle the case when observationDate is a constant in the tmcf.
+		if col.Property == "observationDate" {
+			allNodes[n].date = cell
 		}
-		if _, ok := memDb.statSeries[obs.statVar][obs.place]; !ok {
-			memDb.statSeries[obs.statVar][obs.place] = []*pb.Series{}
+		if col.Property == "observationAbout" {
+			allNodes[n].place = cell
 		}
-		if obs.date != "" && obs.value != "" {
-			v, err := strconv.ParseFloat(obs.value, 64)
-			if err != nil {
-				return err
-			}
-			exist := false
-			for _, series := range memDb.statSeries[obs.statVar][obs.place] {
-				if series.Metadata.String() == obs.meta.String() {
-					series.Val[obs.date] = v
-					exist = true
-				}
-			}
-			if !exist {
-				memDb.statSeries[obs.statVar][obs.place] = append(
-					memDb.statSeries[obs.statVar][obs.place],
-					&pb.Series{
-						Val:      map[string]float64{obs.date: v},
-						Metadata: obs.meta,
-					},
-				)
-			}
+	}
+}
+
+// addNodeObs adds one observation node to memdb. This is shared by all the
+// input formats. Observations without a stat var or place are skipped. Caller
+// should hold the lock.
+func (memDb *MemDb) addNodeObs(obs *nodeObs) error {
+	if obs.statVar == "" || obs.place == "" {
+		return nil
+	}
+	svID, placeID := memDb.touch(obs.statVar, obs.place)
+	if obs.date != "" && obs.num != nil {
+		memDb.addObs(svID, placeID, obs.meta, obs.date, *obs.num)
+	} else if obs.date != "" && obs.value != "" {
+		v, err := strconv.ParseFloat(obs.value, 64)
+		if err != nil {
+			return err
 		}
+		memDb.addObs(svID, placeID, obs.meta, obs.date, v)
 	}
 	return nil
 }
 
-// SubscribeGcsUpdate subscribe GCS csv+tmcf change.
-// When csv file is changed, reload the memdb
+// touch makes sure the <stat var, place> entry exists and returns the ids.
+// Caller should hold the lock.
+func (memDb *MemDb) touch(statVar, place string) (int32, int32) {
+	svID := memDb.statVars.intern(statVar)
+	placeID := memDb.places.intern(place)
+	if _, ok := memDb.columns[svID]; !ok {
+		memDb.columns[svID] = map[int32][]*column{}
+	}
+	if _, ok := memDb.columns[svID][placeID]; !ok {
+		memDb.columns[svID][placeID] = []*column{}
+	}
+	return svID, placeID
+}
+
+// metaID interns the metadata and returns its index in metas. Caller should
+// hold the lock.
+func (memDb *MemDb) metaID(meta *pb.StatMetadata) int32 {
+	metaKey := meta.String()
+	metaID, ok := memDb.metaIds[metaKey]
+	if !ok {
+		metaID = int32(len(memDb.metas))
+		memDb.metaIds[metaKey] = metaID
+		memDb.metas = append(memDb.metas, meta)
+	}
+	return metaID
+}
+
+// addObs adds one observation to the column of the metadata, creating the
+// column if needed. Caller should hold the lock.
+func (memDb *MemDb) addObs(
+	svID, placeID int32, meta *pb.StatMetadata, date string, value float64) {
+	metaID := memDb.metaID(meta)
+	dateID := memDb.dates.intern(date)
+	columns := memDb.columns[svID][placeID]
+	for _, c := range columns {
+		if c.meta == metaID {
+			c.set(memDb.dates, dateID, value)
+			return
+		}
+	}
+	c := &column{meta: metaID}
+	c.set(memDb.dates, dateID, value)
+	memDb.columns[svID][placeID] = append(columns, c)
+}
+
+// logStats logs the size of the memory database. Caller should hold the lock.
+func (memDb *MemDb) logStats() {
+	stats := memDb.stats()
+	log.Printf(
+		"Memdb has %d stat vars, %d places, %d series, %d observations, approx %d MB",
+		stats.NumStatVars, stats.NumPlaces, stats.NumSeries, stats.NumObservations,
+		stats.ApproxBytes>>20)
+}
+
+// SubscribeGcsUpdate subscribe GCS source file change.
+// When a source file is changed, reload the memdb
 func (memDb *MemDb) SubscribeGcsUpdate(
 	ctx context.Context,
 	pubsubProject, pubsubTopic, subscriberPrefix string,
@@ -401,11 +700,11 @@ func (memDb *MemDb) SubscribeGcsUpdate(
 				}
 			}
 			if objectID, ok := msg.Attributes["objectId"]; ok {
-				if !strings.HasSuffix(objectID, ".csv") {
+				if !isSourceFile(objectID) {
 					return nil
 				}
 			}
-			log.Println("Receive notification for csv update")
+			log.Println("Receive notification for source file update")
 			return memDb.LoadFromGcs(ctx, bucket, folder)
 		},
 	)
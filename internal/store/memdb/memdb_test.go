@@ -23,6 +23,20 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 )
 
+// dump materializes all the series in a MemDb.
+func dump(memDb *MemDb) map[string]map[string][]*pb.Series {
+	result := map[string]map[string][]*pb.Series{}
+	for svID, placeData := range memDb.columns {
+		statVar := memDb.statVars.get(svID)
+		result[statVar] = map[string][]*pb.Series{}
+		for placeID := range placeData {
+			place := memDb.places.get(placeID)
+			result[statVar][place] = memDb.ReadSeries(statVar, place)
+		}
+	}
+	return result
+}
+
 var (
 	ts = &tmcf.TableSchema{
 		ColumnInfo: map[string][]*tmcf.Column{
@@ -182,9 +196,98 @@ func TestAddRow(t *testing.T) {
 				t.Fail()
 			}
 		}
-		if diff := cmp.Diff(memDb.statSeries, c.want, protocmp.Transform()); diff != "" {
+		if diff := cmp.Diff(dump(memDb), c.want, protocmp.Transform()); diff != "" {
 			t.Errorf("ParseTmcf got diff: %v", diff)
 			continue
 		}
 	}
 }
+
+func TestReadColumnar(t *testing.T) {
+	header := []string{
+		"Date",
+		"GeoId",
+		"CumulativeCount_Vaccine_COVID_19_Administered",
+		"IncrementalCount_Vaccine_COVID_19_Administered",
+	}
+	// Rows are not in date order and have a duplicate date.
+	rows := [][]string{
+		{"2020-03-23", "country/USA", "250", "25"},
+		{"2020-03-21", "country/USA", "100", "10"},
+		{"2020-03-22", "country/USA", "180", "18"},
+		{"2020-03-22", "country/USA", "200", "20"},
+		{"2020-04-01", "country/USA", "300", "30"},
+	}
+	memDb := NewMemDb()
+	memDb.manifest = manifest
+	for _, row := range rows {
+		if err := memDb.addRow(header, row, ts); err != nil {
+			t.Fatalf("addRow() got error %v", err)
+		}
+	}
+	sv := "CumulativeCount_Vaccine_COVID_19_Administered"
+	meta := &pb.StatMetadata{
+		MeasurementMethod: "OurWorldInData_COVID19",
+		ImportName:        "Private Import",
+		ProvenanceUrl:     "private.domain",
+	}
+
+	for _, c := range []struct {
+		date string
+		want *pb.PointStat
+	}{
+		{"", &pb.PointStat{Date: "2020-04-01", Value: 300}},
+		{"2020-03-22", &pb.PointStat{Date: "2020-03-22", Value: 200}},
+		{"2020-03-24", nil},
+	} {
+		got, gotMeta := memDb.ReadPointValue(sv, "country/USA", c.date)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("ReadPointValue(%s) got diff: %v", c.date, diff)
+		}
+		if c.want != nil {
+			if diff := cmp.Diff(gotMeta, meta, protocmp.Transform()); diff != "" {
+				t.Errorf("ReadPointValue(%s) got diff metadata: %v", c.date, diff)
+			}
+		}
+	}
+
+	for _, c := range []struct {
+		start, end string
+		want       map[string]float64
+	}{
+		{"2020-03-22", "2020-03-23", map[string]float64{"2020-03-22": 200, "2020-03-23": 250}},
+		{"", "2020-03", map[string]float64{"2020-03-21": 100, "2020-03-22": 200, "2020-03-23": 250}},
+		{"2020-04", "", map[string]float64{"2020-04-01": 300}},
+		{"2021", "", nil},
+	} {
+		var got map[string]float64
+		series := memDb.ReadSeriesInRange(sv, "country/USA", c.start, c.end)
+		if len(series) > 0 {
+			got = series[0].Val
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("ReadSeriesInRange(%s, %s) got diff: %v", c.start, c.end, diff)
+		}
+	}
+
+	stats := memDb.Stats()
+	if stats.NumSeries != 2 || stats.NumObservations != 8 || stats.NumDates != 4 {
+		t.Errorf("Stats() got %+v", stats)
+	}
+}
+
+func TestAddNodeObsMissingKeys(t *testing.T) {
+	memDb := NewMemDb()
+	for _, obs := range []*nodeObs{
+		{statVar: "Count_Person", date: "2020", value: "1"},
+		{place: "geoId/06", date: "2020", value: "1"},
+	} {
+		if err := memDb.addNodeObs(obs); err != nil {
+			t.Fatalf("addNodeObs() got error %v", err)
+		}
+	}
+	if !memDb.IsEmpty() {
+		t.Errorf("addNodeObs() should skip observations without stat var or place, got %v",
+			dump(memDb))
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/datacommonsorg/mixer/internal/parser/tmcf"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// parquetBatchSize is the number of rows read from each column at a time.
+const parquetBatchSize = 10000
+
+// bytesFile is a read only source.ParquetFile over a downloaded object.
+type bytesFile struct {
+	*bytes.Reader
+	data []byte
+}
+
+func newBytesFile(data []byte) *bytesFile {
+	return &bytesFile{Reader: bytes.NewReader(data), data: data}
+}
+
+func (f *bytesFile) Open(name string) (source.ParquetFile, error) {
+	return newBytesFile(f.data), nil
+}
+
+func (f *bytesFile) Create(name string) (source.ParquetFile, error) {
+	return nil, status.Errorf(codes.Internal, "Parquet file is read only")
+}
+
+func (f *bytesFile) Write(p []byte) (int, error) {
+	return 0, status.Errorf(codes.Internal, "Parquet file is read only")
+}
+
+func (f *bytesFile) Close() error {
+	return nil
+}
+
+// addParquet adds the rows of a parquet file to memdb and returns the number of
+// rows. Columns are mapped the same way as csv columns, but numeric columns are
+// read natively.
+func (memDb *MemDb) addParquet(data []byte, schemaMapping *tmcf.TableSchema) (int, error) {
+	if schemaMapping == nil {
+		return 0, status.Errorf(
+			codes.Internal, "No schema mapping found for parquet file")
+	}
+	pr, err := reader.NewParquetColumnReader(newBytesFile(data), 1)
+	if err != nil {
+		return 0, err
+	}
+	defer pr.ReadStop()
+	// The first schema element is the root.
+	elements := pr.Footer.GetSchema()[1:]
+	header := make([]string, len(elements))
+	isDate := make([]bool, len(elements))
+	for i, e := range elements {
+		if e.GetNumChildren() > 0 {
+			return 0, status.Errorf(
+				codes.Internal, "Nested parquet column is not supported: %s", e.GetName())
+		}
+		// Reader renames the schema elements, the original name is kept in infos.
+		header[i] = pr.SchemaHandler.Infos[i+1].ExName
+		isDate[i] = e.IsSetConvertedType() &&
+			e.GetConvertedType() == parquet.ConvertedType_DATE
+	}
+	numRows := pr.GetNumRows()
+	for start := int64(0); start < numRows; start += parquetBatchSize {
+		n := numRows - start
+		if n > parquetBatchSize {
+			n = parquetBatchSize
+		}
+		columns := make([][]interface{}, len(header))
+		for i := range header {
+			columns[i], _, _, err = pr.ReadColumnByIndex(int64(i), n)
+			if err != nil {
+				return 0, err
+			}
+		}
+		for r := 0; r < int(n); r++ {
+			allNodes := memDb.newNodes(schemaMapping)
+			for i, colName := range header {
+				if r >= len(columns[i]) {
+					continue
+				}
+				cell, num := parquetCell(columns[i][r], isDate[i])
+				if cell == "" {
+					continue
+				}
+				setProperties(allNodes, schemaMapping.ColumnInfo[colName], cell, num)
+			}
+			for _, obs := range allNodes {
+				if err := memDb.addNodeObs(obs); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	return int(numRows), nil
+}
+
+// parquetCell formats a parquet value as cell text. For numeric values, the
+// native number is also returned.
+func parquetCell(v interface{}, isDate bool) (string, *float64) {
+	var num float64
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		cell := strings.TrimSpace(x)
+		if cell != "" && cell[0] == '[' && cell[len(cell)-1] == ']' {
+			cell = tmcf.ParseComplexValue(cell)
+		}
+		return cell, nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case int32:
+		if isDate {
+			// DATE is the number of days from the Unix epoch.
+			return time.Unix(int64(x)*86400, 0).UTC().Format("2006-01-02"), nil
+		}
+		num = float64(x)
+	case int64:
+		num = float64(x)
+	case float32:
+		num = float64(x)
+	case float64:
+		num = x
+	default:
+		return "", nil
+	}
+	return strconv.FormatFloat(num, 'f', -1, 64), &num
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"bytes"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/xitongsys/parquet-go/writer"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+type vaccineRow struct {
+	Date        string   `parquet:"name=Date, type=BYTE_ARRAY, convertedtype=UTF8"`
+	GeoID       string   `parquet:"name=GeoId, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Cumulative  int64    `parquet:"name=CumulativeCount_Vaccine_COVID_19_Administered, type=INT64"`
+	Incremental *float64 `parquet:"name=IncrementalCount_Vaccine_COVID_19_Administered, type=DOUBLE, repetitiontype=OPTIONAL"`
+}
+
+func TestAddParquet(t *testing.T) {
+	incremental := 10.5
+	rows := []*vaccineRow{
+		{"2020-03-22", "country/AFG", 100, &incremental},
+		{"2020-03-23", "country/AFG", 9007199254740993, nil},
+	}
+	var buf bytes.Buffer
+	pw, err := writer.NewParquetWriterFromWriter(&buf, new(vaccineRow), 1)
+	if err != nil {
+		t.Fatalf("NewParquetWriterFromWriter() got error %v", err)
+	}
+	for _, row := range rows {
+		if err := pw.Write(row); err != nil {
+			t.Fatalf("Write() got error %v", err)
+		}
+	}
+	if err := pw.WriteStop(); err != nil {
+		t.Fatalf("WriteStop() got error %v", err)
+	}
+
+	memDb := NewMemDb()
+	memDb.manifest = manifest
+	n, err := memDb.addParquet(buf.Bytes(), ts)
+	if err != nil {
+		t.Fatalf("addParquet() got error %v", err)
+	}
+	if n != len(rows) {
+		t.Errorf("addParquet() = %d, want %d", n, len(rows))
+	}
+	meta := &pb.StatMetadata{
+		MeasurementMethod: "OurWorldInData_COVID19",
+		ImportName:        "Private Import",
+		ProvenanceUrl:     "private.domain",
+	}
+	want := map[string]map[string][]*pb.Series{
+		"CumulativeCount_Vaccine_COVID_19_Administered": {
+			"country/AFG": {
+				{
+					Val: map[string]float64{
+						"2020-03-22": 100,
+						"2020-03-23": 9007199254740993,
+					},
+					Metadata: meta,
+				},
+			},
+		},
+		"IncrementalCount_Vaccine_COVID_19_Administered": {
+			"country/AFG": {
+				{
+					Val:      map[string]float64{"2020-03-22": 10.5},
+					Metadata: meta,
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(dump(memDb), want, protocmp.Transform()); diff != "" {
+		t.Errorf("addParquet() got diff: %v", diff)
+	}
+}
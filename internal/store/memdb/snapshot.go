@@ -0,0 +1,165 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"path"
+	"sort"
+
+	"cloud.google.com/go/storage"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// snapshotFile is the name of the snapshot object within the import folder.
+	snapshotFile = "memdb.snapshot.pb"
+	// snapshotVersion should be bumped whenever the snapshot content changes in
+	// an incompatible way, so old snapshots are rebuilt from csv.
+	snapshotVersion = 2
+)
+
+// snapshotObject returns the GCS object name of the snapshot for an import
+// folder.
+func snapshotObject(prefix string) string {
+	return path.Join(prefix, snapshotFile)
+}
+
+// isFresh checks if a snapshot is built from exactly the given source objects.
+func isFresh(snapshot *pb.MemDbSnapshot, generations map[string]int64) bool {
+	if snapshot.GetVersion() != snapshotVersion {
+		return false
+	}
+	if len(snapshot.GetGenerations()) != len(generations) {
+		return false
+	}
+	for object, gen := range generations {
+		if snapshot.GetGenerations()[object] != gen {
+			return false
+		}
+	}
+	return true
+}
+
+// toSnapshot converts the memory database content to a snapshot.
+func (memDb *MemDb) toSnapshot(generations map[string]int64) *pb.MemDbSnapshot {
+	snapshot := &pb.MemDbSnapshot{
+		Version:     snapshotVersion,
+		Generations: generations,
+		Manifest:    memDb.manifest,
+		Data:        map[string]*pb.PlaceSeriesList{},
+	}
+	for svID, placeData := range memDb.columns {
+		placeSeries := &pb.PlaceSeriesList{Data: map[string]*pb.SeriesList{}}
+		for placeID, columns := range placeData {
+			seriesList := &pb.SeriesList{}
+			for _, c := range columns {
+				seriesList.Series = append(
+					seriesList.Series, memDb.toSeries(c, 0, len(c.dates)))
+			}
+			placeSeries.Data[memDb.places.get(placeID)] = seriesList
+		}
+		snapshot.Data[memDb.statVars.get(svID)] = placeSeries
+	}
+	return snapshot
+}
+
+// fromSnapshot populates the memory database from a snapshot.
+func (memDb *MemDb) fromSnapshot(snapshot *pb.MemDbSnapshot) {
+	memDb.reset()
+	if snapshot.GetManifest() != nil {
+		memDb.manifest = snapshot.GetManifest()
+	}
+	for statVar, placeSeries := range snapshot.GetData() {
+		for place, seriesList := range placeSeries.GetData() {
+			svID, placeID := memDb.touch(statVar, place)
+			for _, series := range seriesList.GetSeries() {
+				// Each series is a column of the snapshot, so the column is built
+				// in one pass from the sorted dates.
+				val := series.GetVal()
+				dates := make([]string, 0, len(val))
+				for date := range val {
+					dates = append(dates, date)
+				}
+				sort.Strings(dates)
+				c := &column{
+					meta:   memDb.metaID(series.GetMetadata()),
+					dates:  make([]int32, len(dates)),
+					values: make([]float64, len(dates)),
+				}
+				for i, date := range dates {
+					c.dates[i] = memDb.dates.intern(date)
+					c.values[i] = val[date]
+				}
+				memDb.columns[svID][placeID] = append(memDb.columns[svID][placeID], c)
+			}
+		}
+	}
+}
+
+// readSnapshot reads the snapshot object from GCS.
+func readSnapshot(ctx context.Context, obj *storage.ObjectHandle) (
+	*pb.MemDbSnapshot, error) {
+	r, err := obj.NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	bytes, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &pb.MemDbSnapshot{}
+	if err := proto.Unmarshal(bytes, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// writeSnapshot writes the memory database content to GCS.
+//
+// The write is conditioned on the snapshot generation seen when listing the
+// folder, so replicas starting at the same time do not overwrite each other.
+// A failed write is only logged as the data is already loaded.
+func (memDb *MemDb) writeSnapshot(
+	ctx context.Context,
+	obj *storage.ObjectHandle,
+	snapshotGen int64,
+	generations map[string]int64,
+) {
+	bytes, err := proto.Marshal(memDb.toSnapshot(generations))
+	if err != nil {
+		log.Printf("Failed to marshal memdb snapshot: %v", err)
+		return
+	}
+	cond := storage.Conditions{DoesNotExist: true}
+	if snapshotGen != 0 {
+		cond = storage.Conditions{GenerationMatch: snapshotGen}
+	}
+	w := obj.If(cond).NewWriter(ctx)
+	if _, err := w.Write(bytes); err != nil {
+		log.Printf("Failed to write memdb snapshot: %v", err)
+		_ = w.Close()
+		return
+	}
+	if err := w.Close(); err != nil {
+		log.Printf("Failed to write memdb snapshot: %v", err)
+		return
+	}
+	log.Printf("Wrote memdb snapshot gs://%s/%s", obj.BucketName(), obj.ObjectName())
+}
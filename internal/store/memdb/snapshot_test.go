@@ -0,0 +1,103 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	header := []string{
+		"Date",
+		"GeoId",
+		"CumulativeCount_Vaccine_COVID_19_Administered",
+		"IncrementalCount_Vaccine_COVID_19_Administered",
+	}
+	rows := [][]string{
+		{"2020-03-22", "country/AFG", "100", "10"},
+		{"2020-03-23", "country/AFG", "150", "50"},
+		{"2020-03-22", "country/ALB", "30", ""},
+		{"2020-03-21", "country/AFG", "60", "60"},
+	}
+	memDb := NewMemDb()
+	memDb.manifest = manifest
+	for _, row := range rows {
+		if err := memDb.addRow(header, row, ts); err != nil {
+			t.Fatalf("addRow() got error %v", err)
+		}
+	}
+	generations := map[string]int64{"test/data.csv": 1, "test/data.tmcf": 2}
+	bytes, err := proto.Marshal(memDb.toSnapshot(generations))
+	if err != nil {
+		t.Fatalf("proto.Marshal() got error %v", err)
+	}
+	snapshot := &pb.MemDbSnapshot{}
+	if err := proto.Unmarshal(bytes, snapshot); err != nil {
+		t.Fatalf("proto.Unmarshal() got error %v", err)
+	}
+	got := NewMemDb()
+	got.fromSnapshot(snapshot)
+	if diff := cmp.Diff(dump(got), dump(memDb), protocmp.Transform()); diff != "" {
+		t.Errorf("fromSnapshot() got diff series: %v", diff)
+	}
+	if diff := cmp.Diff(got.manifest, memDb.manifest, protocmp.Transform()); diff != "" {
+		t.Errorf("fromSnapshot() got diff manifest: %v", diff)
+	}
+	// The restored columns are sorted by date.
+	gotRange := got.ReadSeriesInRange(
+		"CumulativeCount_Vaccine_COVID_19_Administered", "country/AFG", "2020-03-22", "")
+	wantRange := memDb.ReadSeriesInRange(
+		"CumulativeCount_Vaccine_COVID_19_Administered", "country/AFG", "2020-03-22", "")
+	if diff := cmp.Diff(gotRange, wantRange, protocmp.Transform()); diff != "" {
+		t.Errorf("ReadSeriesInRange() after fromSnapshot() got diff: %v", diff)
+	}
+	gotStats, wantStats := got.Stats(), memDb.Stats()
+	if gotStats.NumObservations != wantStats.NumObservations ||
+		gotStats.NumSeries != wantStats.NumSeries {
+		t.Errorf("Stats() after fromSnapshot() = %+v, want %+v", gotStats, wantStats)
+	}
+}
+
+func TestIsFresh(t *testing.T) {
+	snapshot := &pb.MemDbSnapshot{
+		Version:     snapshotVersion,
+		Generations: map[string]int64{"a.csv": 1, "a.tmcf": 2},
+	}
+	for _, c := range []struct {
+		generations map[string]int64
+		want        bool
+	}{
+		{map[string]int64{"a.csv": 1, "a.tmcf": 2}, true},
+		{map[string]int64{"a.csv": 3, "a.tmcf": 2}, false},
+		{map[string]int64{"a.csv": 1}, false},
+		{map[string]int64{"a.csv": 1, "a.tmcf": 2, "b.csv": 1}, false},
+	} {
+		if got := isFresh(snapshot, c.generations); got != c.want {
+			t.Errorf("isFresh(%v) = %v, want %v", c.generations, got, c.want)
+		}
+	}
+	old := &pb.MemDbSnapshot{
+		Version:     snapshotVersion - 1,
+		Generations: map[string]int64{"a.csv": 1, "a.tmcf": 2},
+	}
+	if isFresh(old, map[string]int64{"a.csv": 1, "a.tmcf": 2}) {
+		t.Errorf("isFresh() should reject snapshot of old version")
+	}
+}